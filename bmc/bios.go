@@ -3,6 +3,7 @@ package bmc
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	bmclibErrs "github.com/metal-toolbox/bmclib/errors"
@@ -50,9 +51,15 @@ Loop:
 			break Loop
 		default:
 			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
-			biosConfig, vErr := elem.GetBiosConfiguration(ctx)
+			start := time.Now()
+			var biosConfig map[string]string
+			vErr := metadata.retry(ctx, elem.name, "GetBiosConfiguration", true, func() (err error) {
+				biosConfig, err = elem.GetBiosConfiguration(ctx)
+				return err
+			})
+			metadata.setProviderDuration(elem.name, time.Since(start))
 			if vErr != nil {
-				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				err = multierror.Append(err, metadata.providerFailed(elem.name, "GetBiosConfiguration", vErr))
 				err = multierror.Append(err, vErr)
 				continue
 
@@ -78,9 +85,13 @@ Loop:
 			break Loop
 		default:
 			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
-			vErr := elem.SetBiosConfiguration(ctx, biosConfig)
+			start := time.Now()
+			vErr := metadata.retry(ctx, elem.name, "SetBiosConfiguration", true, func() error {
+				return elem.SetBiosConfiguration(ctx, biosConfig)
+			})
+			metadata.setProviderDuration(elem.name, time.Since(start))
 			if vErr != nil {
-				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				err = multierror.Append(err, metadata.providerFailed(elem.name, "SetBiosConfiguration", vErr))
 				err = multierror.Append(err, vErr)
 				continue
 
@@ -106,9 +117,13 @@ Loop:
 			break Loop
 		default:
 			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
-			vErr := elem.SetBiosConfigurationFromFile(ctx, cfg)
+			start := time.Now()
+			vErr := metadata.retry(ctx, elem.name, "SetBiosConfigurationFromFile", true, func() error {
+				return elem.SetBiosConfigurationFromFile(ctx, cfg)
+			})
+			metadata.setProviderDuration(elem.name, time.Since(start))
 			if vErr != nil {
-				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				err = multierror.Append(err, metadata.providerFailed(elem.name, "SetBiosConfigurationFromFile", vErr))
 				err = multierror.Append(err, vErr)
 				continue
 
@@ -134,9 +149,13 @@ Loop:
 			break Loop
 		default:
 			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
-			vErr := elem.ResetBiosConfiguration(ctx)
+			start := time.Now()
+			vErr := metadata.retry(ctx, elem.name, "ResetBiosConfiguration", true, func() error {
+				return elem.ResetBiosConfiguration(ctx)
+			})
+			metadata.setProviderDuration(elem.name, time.Since(start))
 			if vErr != nil {
-				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				err = multierror.Append(err, metadata.providerFailed(elem.name, "ResetBiosConfiguration", vErr))
 				err = multierror.Append(err, vErr)
 				continue
 
@@ -1,7 +1,12 @@
 package bmc
 
 import (
+	"crypto/sha256"
+	"fmt"
 	"strings"
+	"time"
+
+	bmclibErrs "github.com/metal-toolbox/bmclib/errors"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -19,6 +24,25 @@ type Metadata struct {
 	SuccessfulCloseConns []string
 	// FailedProviderDetail holds the failed providers error messages for called methods
 	FailedProviderDetail map[string]string
+	// FailedProviderErrors holds the failed providers typed and classified errors for called methods
+	FailedProviderErrors map[string]*bmclibErrs.ProviderError
+	// ProviderDurations holds the time taken by each attempted provider for called methods
+	ProviderDurations map[string]time.Duration
+	// ProviderAttempts holds the number of attempts made for providers that were retried as per the RetryPolicy
+	ProviderAttempts map[string]int
+	// ProviderResults holds the normalized result returned by each successful provider, a summary of the results
+	// like the inventory and the System Event Log entries that are compared on a subset of their fields,
+	// this is only populated when the consensus execution strategy is used.
+	ProviderResults map[string]string
+	// Conflict is set when the providers in ProviderResults returned different results.
+	Conflict bool
+	// ProviderCredentials holds the index of the credentials each provider was opened with,
+	// 0 for the Client credentials and n for the nth fallback credentials.
+	// This is only populated by Open when fallback credentials are set.
+	ProviderCredentials map[string]int
+	// Escalated is set when an operation was escalated to a more forceful one,
+	// like a graceful power off that was followed by a hard power off.
+	Escalated bool
 }
 
 func newMetadata() Metadata {
@@ -27,6 +51,39 @@ func newMetadata() Metadata {
 	}
 }
 
+// providerFailed records the error returned by a provider for the operation
+// and returns it wrapped in a ProviderError, the map is initialized here since not all callers use newMetadata.
+func (m *Metadata) providerFailed(provider, operation string, err error) error {
+	pErr := bmclibErrs.NewProviderError(provider, operation, err)
+
+	if m.FailedProviderErrors == nil {
+		m.FailedProviderErrors = make(map[string]*bmclibErrs.ProviderError)
+	}
+
+	m.FailedProviderErrors[provider] = pErr
+
+	return pErr
+}
+
+// setProviderDuration records the time taken by a provider,
+// the map is initialized here since not all callers use newMetadata.
+func (m *Metadata) setProviderDuration(provider string, d time.Duration) {
+	if m.ProviderDurations == nil {
+		m.ProviderDurations = make(map[string]time.Duration)
+	}
+
+	m.ProviderDurations[provider] = d
+}
+
+// setProviderAttempts records the number of attempts made for a provider.
+func (m *Metadata) setProviderAttempts(provider string, attempts int) {
+	if m.ProviderAttempts == nil {
+		m.ProviderAttempts = make(map[string]int)
+	}
+
+	m.ProviderAttempts[provider] = attempts
+}
+
 func (m *Metadata) RegisterSpanAttributes(host string, span trace.Span) {
 	span.SetAttributes(attribute.String("host", host))
 
@@ -49,4 +106,54 @@ func (m *Metadata) RegisterSpanAttributes(host string, span trace.Span) {
 			attribute.String("provider-errs-"+p, e),
 		)
 	}
+
+	for p, e := range m.FailedProviderErrors {
+		span.SetAttributes(
+			attribute.String("provider-err-class-"+p, string(e.Class)),
+		)
+	}
+
+	for p, d := range m.ProviderDurations {
+		span.SetAttributes(
+			attribute.String("provider-duration-"+p, d.String()),
+		)
+	}
+
+	for p, a := range m.ProviderAttempts {
+		span.SetAttributes(
+			attribute.Int("provider-attempts-"+p, a),
+		)
+	}
+
+	if len(m.ProviderResults) > 0 {
+		for p, r := range m.ProviderResults {
+			span.SetAttributes(
+				attribute.String("provider-result-"+p, spanResult(r)),
+			)
+		}
+
+		span.SetAttributes(attribute.Bool("provider-results-conflict", m.Conflict))
+	}
+
+	if m.Escalated {
+		span.SetAttributes(attribute.Bool("escalated", true))
+	}
+
+	for p, i := range m.ProviderCredentials {
+		span.SetAttributes(
+			attribute.Int("provider-credentials-"+p, i),
+		)
+	}
+}
+
+// maxSpanResultLen is the length above which the provider results are recorded as a digest in the span attributes
+const maxSpanResultLen = 128
+
+// spanResult returns the provider result as recorded in the span attributes, a digest of the results that are too long.
+func spanResult(result string) string {
+	if len(result) <= maxSpanResultLen {
+		return result
+	}
+
+	return fmt.Sprintf("sha256=%x", sha256.Sum256([]byte(result)))
 }
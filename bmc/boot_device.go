@@ -3,6 +3,7 @@ package bmc
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-multierror"
@@ -73,9 +74,15 @@ func setBootDevice(ctx context.Context, timeout time.Duration, bootDevice string
 			metadataLocal.ProvidersAttempted = append(metadataLocal.ProvidersAttempted, elem.name)
 			ctx, cancel := context.WithTimeout(ctx, timeout)
 			defer cancel()
-			ok, setErr := elem.bootDeviceSetter.BootDeviceSet(ctx, bootDevice, setPersistent, efiBoot)
+			start := time.Now()
+			var ok bool
+			setErr := metadataLocal.retry(ctx, elem.name, "BootDeviceSet", true, func() (err error) {
+				ok, err = elem.bootDeviceSetter.BootDeviceSet(ctx, bootDevice, setPersistent, efiBoot)
+				return err
+			})
+			metadataLocal.setProviderDuration(elem.name, time.Since(start))
 			if setErr != nil {
-				err = multierror.Append(err, errors.WithMessagef(setErr, "provider: %v", elem.name))
+				err = multierror.Append(err, metadataLocal.providerFailed(elem.name, "BootDeviceSet", setErr))
 				metadataLocal.FailedProviderDetail[elem.name] = setErr.Error()
 				continue
 			}
@@ -127,7 +134,12 @@ func getBootDeviceOverride(
 		ctx, cancel := context.WithTimeout(ctx, timeout)
 		defer cancel()
 
-		override, err = provider.bootOverrideGetter.BootDeviceOverrideGet(ctx)
+		start := time.Now()
+		err = metadata.retry(ctx, provider.name, "BootDeviceOverrideGet", true, func() (err error) {
+			override, err = provider.bootOverrideGetter.BootDeviceOverrideGet(ctx)
+			return err
+		})
+		metadata.setProviderDuration(provider.name, time.Since(start))
 		if err != nil {
 			metadata.FailedProviderDetail[provider.name] = err.Error()
 			return override, ok, nil
@@ -147,6 +159,10 @@ func GetBootDeviceOverrideFromInterface(
 ) (override BootDeviceOverride, metadata Metadata, err error) {
 	metadata = newMetadata()
 
+	if strategy, _ := ExecutionStrategyFromContext(ctx); strategy.concurrent() {
+		return getBootDeviceOverrideConcurrent(ctx, timeout, strategy, providers)
+	}
+
 	for _, elem := range providers {
 		switch p := elem.(type) {
 		case BootDeviceOverrideGetter:
@@ -169,3 +185,39 @@ func GetBootDeviceOverrideFromInterface(
 
 	return override, metadata, err
 }
+
+// getBootDeviceOverrideConcurrent gets the boot device override settings from all BootDeviceOverrideGetter
+// providers concurrently as per the execution strategy.
+func getBootDeviceOverrideConcurrent(
+	ctx context.Context,
+	timeout time.Duration,
+	strategy ExecutionStrategy,
+	providers []interface{},
+) (override BootDeviceOverride, metadata Metadata, err error) {
+	calls := make([]providerCall[BootDeviceOverride], 0, len(providers))
+	for _, elem := range providers {
+		switch p := elem.(type) {
+		case BootDeviceOverrideGetter:
+			calls = append(calls, providerCall[BootDeviceOverride]{name: getProviderName(elem), call: p.BootDeviceOverrideGet})
+		default:
+			e := fmt.Errorf("not a BootDeviceOverrideGetter implementation: %T", p)
+			err = multierror.Append(err, e)
+		}
+	}
+
+	if len(calls) == 0 {
+		return override, newMetadata(), multierror.Append(err, errors.New("no BootDeviceOverrideGetter implementations found"))
+	}
+
+	return runConcurrent(ctx, timeout, strategy, "BootDeviceOverrideGet", calls, normalizeBootDeviceOverride, "failed to get boot device override settings")
+}
+
+// normalizeBootDeviceOverride returns a comparable representation of the boot device override settings.
+func normalizeBootDeviceOverride(override BootDeviceOverride) string {
+	return fmt.Sprintf(
+		"device=%s,persistent=%t,efi=%t",
+		strings.ToLower(string(override.Device)),
+		override.IsPersistent,
+		override.IsEFIBoot,
+	)
+}
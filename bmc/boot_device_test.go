@@ -241,6 +241,12 @@ func TestBootDeviceOverrideGet(t *testing.T) {
 				assert.Nil(t, err)
 			}
 			assert.Equal(t, testCase.expectedOverride, override)
+			// durations vary between runs, only the providers they are recorded for are compared
+			for _, name := range metadata.ProvidersAttempted {
+				assert.Contains(t, metadata.ProviderDurations, name)
+			}
+			metadata.ProviderDurations = nil
+
 			assert.Equal(t, testCase.expectedMetadata, &metadata)
 		})
 	}
@@ -0,0 +1,126 @@
+package bmc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/jacobweinstock/registrar"
+	"github.com/pkg/errors"
+)
+
+// Capabilities describes the features a provider supports on a BMC, as discovered at runtime.
+type Capabilities struct {
+	// Features are the provider features that were not found to be unsupported by the BMC.
+	Features registrar.Features
+	// Unsupported are the provider features the BMC was found not to support,
+	// for example virtual media on a BMC without a VirtualMedia collection.
+	Unsupported registrar.Features
+	// Details holds provider specific details discovered while probing the BMC,
+	// like the hardware model or the allowable values of an action.
+	Details map[string]string
+	// Probed is true when the capabilities were probed from the BMC,
+	// otherwise Features holds the features declared by the provider.
+	Probed bool
+}
+
+// Supports returns true when none of the given features were found to be unsupported.
+func (c Capabilities) Supports(features ...registrar.Feature) bool {
+	for _, f := range features {
+		for _, u := range c.Unsupported {
+			if f == u {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// CapabilityProber probes the BMC for the features it supports.
+//
+// Probing is expected to be done on an open connection, providers only report the
+// features they are able to confirm as unsupported, features that can't be probed are assumed supported.
+type CapabilityProber interface {
+	ProbeCapabilities(ctx context.Context) (Capabilities, error)
+}
+
+// capabilityProviders is an internal struct to correlate an implementation/provider and its name
+type capabilityProviders struct {
+	name             string
+	capabilityProber CapabilityProber
+}
+
+// probeCapabilities probes all the providers, unlike other methods it does not stop at the first successful provider.
+func probeCapabilities(ctx context.Context, timeout time.Duration, p []capabilityProviders) (capabilities map[string]Capabilities, metadata Metadata, err error) {
+	metadata = newMetadata()
+	capabilities = make(map[string]Capabilities)
+
+	for _, elem := range p {
+		if elem.capabilityProber == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return capabilities, metadata, err
+		default:
+			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
+			probeCtx, cancel := context.WithTimeout(ctx, timeout)
+			start := time.Now()
+			var caps Capabilities
+			probeErr := metadata.retry(probeCtx, elem.name, "ProbeCapabilities", true, func() (err error) {
+				caps, err = elem.capabilityProber.ProbeCapabilities(probeCtx)
+				return err
+			})
+			metadata.setProviderDuration(elem.name, time.Since(start))
+			cancel()
+			if probeErr != nil {
+				err = multierror.Append(err, metadata.providerFailed(elem.name, "ProbeCapabilities", probeErr))
+				metadata.FailedProviderDetail[elem.name] = probeErr.Error()
+				continue
+			}
+
+			caps.Probed = true
+			capabilities[elem.name] = caps
+			if metadata.SuccessfulProvider == "" {
+				metadata.SuccessfulProvider = elem.name
+			}
+		}
+	}
+
+	if len(capabilities) == 0 && err != nil {
+		return capabilities, metadata, multierror.Append(err, errors.New("failed to probe capabilities"))
+	}
+
+	return capabilities, metadata, err
+}
+
+// ProbeCapabilitiesFromInterfaces identifies implementations of the CapabilityProber interface and probes each of them,
+// the capabilities are returned keyed by the provider name.
+//
+// An error is returned along with the capabilities of the providers that were probed when some providers fail.
+func ProbeCapabilitiesFromInterfaces(ctx context.Context, timeout time.Duration, generic []interface{}) (capabilities map[string]Capabilities, metadata Metadata, err error) {
+	probers := make([]capabilityProviders, 0)
+	for _, elem := range generic {
+		if elem == nil {
+			continue
+		}
+		temp := capabilityProviders{name: getProviderName(elem)}
+		switch p := elem.(type) {
+		case CapabilityProber:
+			temp.capabilityProber = p
+			probers = append(probers, temp)
+		default:
+			e := fmt.Sprintf("not a CapabilityProber implementation: %T", p)
+			err = multierror.Append(err, errors.New(e))
+		}
+	}
+	if len(probers) == 0 {
+		return nil, metadata, multierror.Append(err, errors.New("no CapabilityProber implementations found"))
+	}
+
+	return probeCapabilities(ctx, timeout, probers)
+}
@@ -0,0 +1,84 @@
+package bmc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jacobweinstock/registrar"
+	"github.com/stretchr/testify/assert"
+)
+
+type capabilityProberTester struct {
+	name string
+	caps Capabilities
+	err  error
+}
+
+func (c *capabilityProberTester) ProbeCapabilities(_ context.Context) (Capabilities, error) {
+	return c.caps, c.err
+}
+
+func (c *capabilityProberTester) Name() string {
+	return c.name
+}
+
+func TestProbeCapabilitiesFromInterfaces(t *testing.T) {
+	testCases := []struct {
+		name      string
+		providers []interface{}
+		want      map[string]Capabilities
+		wantErr   bool
+	}{
+		{
+			name: "all providers are probed",
+			providers: []interface{}{
+				&capabilityProberTester{name: "one", caps: Capabilities{Features: registrar.Features{"powerstate"}}},
+				&capabilityProberTester{name: "two", caps: Capabilities{Unsupported: registrar.Features{"virtualmedia"}}},
+			},
+			want: map[string]Capabilities{
+				"one": {Features: registrar.Features{"powerstate"}, Probed: true},
+				"two": {Unsupported: registrar.Features{"virtualmedia"}, Probed: true},
+			},
+		},
+		{
+			name: "failed providers are reported",
+			providers: []interface{}{
+				&capabilityProberTester{name: "one", err: errors.New("boom")},
+				&capabilityProberTester{name: "two", caps: Capabilities{Details: map[string]string{"Model": "X11"}}},
+			},
+			want: map[string]Capabilities{
+				"two": {Details: map[string]string{"Model": "X11"}, Probed: true},
+			},
+			wantErr: true,
+		},
+		{
+			name:      "no implementations",
+			providers: []interface{}{"not a prober"},
+			wantErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			caps, _, err := ProbeCapabilitiesFromInterfaces(context.Background(), time.Second, tc.providers)
+			if tc.wantErr {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+
+			if tc.want != nil {
+				assert.Equal(t, tc.want, caps)
+			}
+		})
+	}
+}
+
+func TestCapabilitiesSupports(t *testing.T) {
+	caps := Capabilities{Unsupported: registrar.Features{"virtualmedia"}}
+
+	assert.True(t, caps.Supports("powerstate"))
+	assert.False(t, caps.Supports("powerstate", "virtualmedia"))
+}
@@ -50,6 +50,8 @@ func OpenConnectionFromInterfaces(ctx context.Context, timeout time.Duration, pr
 	type result struct {
 		ProviderName string
 		Opener       Opener
+		Attempts     int
+		Duration     time.Duration
 		Err          error
 	}
 
@@ -72,9 +74,11 @@ func OpenConnectionFromInterfaces(ctx context.Context, timeout time.Duration, pr
 				defer wg.Done()
 				res := result{ProviderName: providerName, Opener: provider}
 
-				if err := provider.Open(ctx); err != nil {
-					res.Err = errors.WithMessagef(err, "provider: %v", providerName)
-				}
+				start := time.Now()
+				res.Attempts, res.Err = retry(ctx, "Open", true, func() error {
+					return provider.Open(ctx)
+				})
+				res.Duration = time.Since(start)
 
 				results <- res
 			}(p, providerName)
@@ -90,7 +94,13 @@ func OpenConnectionFromInterfaces(ctx context.Context, timeout time.Duration, pr
 
 	// Gather and handle results from the opener goroutines.
 	for res := range results {
+		metadata.setProviderDuration(res.ProviderName, res.Duration)
+		if res.Attempts > 1 {
+			metadata.setProviderAttempts(res.ProviderName, res.Attempts)
+		}
+
 		if res.Err != nil {
+			res.Err = metadata.providerFailed(res.ProviderName, "Open", res.Err)
 			err = multierror.Append(err, res.Err)
 			metadata.FailedProviderDetail[res.ProviderName] = res.Err.Error()
 			continue
@@ -117,9 +127,13 @@ func closeConnection(ctx context.Context, c []connectionProviders) (metadata Met
 			continue
 		}
 		metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
-		closeErr := elem.closer.Close(ctx)
+		start := time.Now()
+		closeErr := metadata.retry(ctx, elem.name, "Close", true, func() error {
+			return elem.closer.Close(ctx)
+		})
+		metadata.setProviderDuration(elem.name, time.Since(start))
 		if closeErr != nil {
-			err = multierror.Append(err, errors.WithMessagef(closeErr, "provider: %v", elem.name))
+			err = multierror.Append(err, metadata.providerFailed(elem.name, "Close", closeErr))
 			metadata.FailedProviderDetail[elem.name] = closeErr.Error()
 			continue
 		}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"time"
 
 	"github.com/metal-toolbox/bmclib/constants"
 	bmclibErrs "github.com/metal-toolbox/bmclib/errors"
@@ -49,9 +50,14 @@ func firmwareInstall(ctx context.Context, component, operationApplyTime string,
 			return taskID, metadata, err
 		default:
 			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
-			taskID, vErr := elem.FirmwareInstall(ctx, component, operationApplyTime, forceInstall, reader)
+			start := time.Now()
+			vErr := metadata.retryPayload(ctx, elem.name, "FirmwareInstall", reader, func() (err error) {
+				taskID, err = elem.FirmwareInstall(ctx, component, operationApplyTime, forceInstall, reader)
+				return err
+			})
+			metadata.setProviderDuration(elem.name, time.Since(start))
 			if vErr != nil {
-				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				err = multierror.Append(err, metadata.providerFailed(elem.name, "FirmwareInstall", vErr))
 				metadata.FailedProviderDetail[elem.name] = err.Error()
 				continue
 
@@ -130,9 +136,15 @@ func firmwareInstallStatus(ctx context.Context, installVersion, component, taskI
 			return status, metadata, err
 		default:
 			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
-			status, vErr := elem.FirmwareInstallStatus(ctx, installVersion, component, taskID)
+			start := time.Now()
+			var status string
+			vErr := metadata.retry(ctx, elem.name, "FirmwareInstallStatus", true, func() (err error) {
+				status, err = elem.FirmwareInstallStatus(ctx, installVersion, component, taskID)
+				return err
+			})
+			metadata.setProviderDuration(elem.name, time.Since(start))
 			if vErr != nil {
-				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				err = multierror.Append(err, metadata.providerFailed(elem.name, "FirmwareInstallStatus", vErr))
 				metadata.FailedProviderDetail[elem.name] = err.Error()
 				continue
 
@@ -206,9 +218,14 @@ func firmwareInstallUploadAndInitiate(ctx context.Context, component string, fil
 			return taskID, metadata, err
 		default:
 			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
-			taskID, vErr := elem.FirmwareInstallUploadAndInitiate(ctx, component, file)
+			start := time.Now()
+			vErr := metadata.retryPayload(ctx, elem.name, "FirmwareInstallUploadAndInitiate", file, func() (err error) {
+				taskID, err = elem.FirmwareInstallUploadAndInitiate(ctx, component, file)
+				return err
+			})
+			metadata.setProviderDuration(elem.name, time.Since(start))
 			if vErr != nil {
-				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				err = multierror.Append(err, metadata.providerFailed(elem.name, "FirmwareInstallUploadAndInitiate", vErr))
 				metadata.FailedProviderDetail[elem.name] = err.Error()
 				continue
 			}
@@ -283,10 +300,14 @@ func firmwareInstallUploaded(ctx context.Context, component, uploadTaskID string
 			return installTaskID, metadata, err
 		default:
 			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
-			var vErr error
-			installTaskID, vErr = elem.FirmwareInstallUploaded(ctx, component, uploadTaskID)
+			start := time.Now()
+			vErr := metadata.retry(ctx, elem.name, "FirmwareInstallUploaded", false, func() (err error) {
+				installTaskID, err = elem.FirmwareInstallUploaded(ctx, component, uploadTaskID)
+				return err
+			})
+			metadata.setProviderDuration(elem.name, time.Since(start))
 			if vErr != nil {
-				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				err = multierror.Append(err, metadata.providerFailed(elem.name, "FirmwareInstallUploaded", vErr))
 				metadata.FailedProviderDetail[elem.name] = err.Error()
 				continue
 
@@ -381,9 +402,15 @@ func firmwareInstallSteps(ctx context.Context, component string, generic []firmw
 			return steps, metadata, err
 		default:
 			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
-			steps, vErr := elem.FirmwareInstallSteps(ctx, component)
+			start := time.Now()
+			var steps []constants.FirmwareInstallStep
+			vErr := metadata.retry(ctx, elem.name, "FirmwareInstallSteps", true, func() (err error) {
+				steps, err = elem.FirmwareInstallSteps(ctx, component)
+				return err
+			})
+			metadata.setProviderDuration(elem.name, time.Since(start))
 			if vErr != nil {
-				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				err = multierror.Append(err, metadata.providerFailed(elem.name, "FirmwareInstallSteps", vErr))
 				metadata.FailedProviderDetail[elem.name] = err.Error()
 				continue
 
@@ -449,9 +476,14 @@ func firmwareUpload(ctx context.Context, component string, file *os.File, generi
 			return taskID, metadata, err
 		default:
 			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
-			taskID, vErr := elem.FirmwareUpload(ctx, component, file)
+			start := time.Now()
+			vErr := metadata.retryPayload(ctx, elem.name, "FirmwareUpload", file, func() (err error) {
+				taskID, err = elem.FirmwareUpload(ctx, component, file)
+				return err
+			})
+			metadata.setProviderDuration(elem.name, time.Since(start))
 			if vErr != nil {
-				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				err = multierror.Append(err, metadata.providerFailed(elem.name, "FirmwareUpload", vErr))
 				metadata.FailedProviderDetail[elem.name] = err.Error()
 				continue
 
@@ -505,9 +537,16 @@ func firmwareTaskStatus(ctx context.Context, kind constants.FirmwareInstallStep,
 			return state, status, metadata, err
 		default:
 			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
-			state, status, vErr := elem.FirmwareTaskStatus(ctx, kind, component, taskID, installVersion)
+			var state constants.TaskState
+			start := time.Now()
+			var status string
+			vErr := metadata.retry(ctx, elem.name, "FirmwareTaskStatus", true, func() (err error) {
+				state, status, err = elem.FirmwareTaskStatus(ctx, kind, component, taskID, installVersion)
+				return err
+			})
+			metadata.setProviderDuration(elem.name, time.Since(start))
 			if vErr != nil {
-				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				err = multierror.Append(err, metadata.providerFailed(elem.name, "FirmwareTaskStatus", vErr))
 				metadata.FailedProviderDetail[elem.name] = err.Error()
 				continue
 			}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	bmclibErrs "github.com/metal-toolbox/bmclib/errors"
@@ -37,9 +38,13 @@ func mountFloppyImage(ctx context.Context, image io.Reader, p []floppyImageUploa
 			return metadata, err
 		default:
 			metadataLocal.ProvidersAttempted = append(metadataLocal.ProvidersAttempted, elem.name)
-			uploadErr := elem.impl.MountFloppyImage(ctx, image)
+			start := time.Now()
+			uploadErr := metadataLocal.retryPayload(ctx, elem.name, "MountFloppyImage", image, func() error {
+				return elem.impl.MountFloppyImage(ctx, image)
+			})
+			metadataLocal.setProviderDuration(elem.name, time.Since(start))
 			if uploadErr != nil {
-				err = multierror.Append(err, errors.WithMessagef(uploadErr, "provider: %v", elem.name))
+				err = multierror.Append(err, metadataLocal.providerFailed(elem.name, "MountFloppyImage", uploadErr))
 				continue
 			}
 
@@ -107,9 +112,13 @@ func unmountFloppyImage(ctx context.Context, p []floppyImageUnmounterProvider) (
 			return metadata, err
 		default:
 			metadataLocal.ProvidersAttempted = append(metadataLocal.ProvidersAttempted, elem.name)
-			uploadErr := elem.impl.UnmountFloppyImage(ctx)
+			start := time.Now()
+			uploadErr := metadataLocal.retry(ctx, elem.name, "UnmountFloppyImage", true, func() error {
+				return elem.impl.UnmountFloppyImage(ctx)
+			})
+			metadataLocal.setProviderDuration(elem.name, time.Since(start))
 			if uploadErr != nil {
-				err = multierror.Append(err, errors.WithMessagef(uploadErr, "provider: %v", elem.name))
+				err = multierror.Append(err, metadataLocal.providerFailed(elem.name, "UnmountFloppyImage", uploadErr))
 				continue
 			}
 
@@ -0,0 +1,181 @@
+package bmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
+)
+
+// IdentifyState is the state of the chassis identify LED, used to physically locate a machine.
+type IdentifyState string
+
+const (
+	IdentifyOff   IdentifyState = "off"
+	IdentifyOn    IdentifyState = "on"
+	IdentifyBlink IdentifyState = "blink"
+)
+
+// ParseIdentifyState returns the IdentifyState for the given state, the Redfish IndicatorLED values Lit and Blinking are accepted.
+func ParseIdentifyState(state string) (IdentifyState, error) {
+	switch strings.ToLower(strings.TrimSpace(state)) {
+	case "off":
+		return IdentifyOff, nil
+	case "on", "lit":
+		return IdentifyOn, nil
+	case "blink", "blinking":
+		return IdentifyBlink, nil
+	default:
+		return "", fmt.Errorf("unknown identify state: %q", state)
+	}
+}
+
+// IdentifyGetter gets the state of the chassis identify LED
+type IdentifyGetter interface {
+	IdentifyGet(ctx context.Context) (state IdentifyState, err error)
+}
+
+// IdentifySetter sets the state of the chassis identify LED
+type IdentifySetter interface {
+	// IdentifySet sets the identify LED state, the duration applies to IdentifyBlink and turns
+	// the LED off once elapsed, a zero duration keeps the LED blinking until it is turned off.
+	//
+	// Implementations that can't turn the LED off after a duration return an error for a non zero duration.
+	IdentifySet(ctx context.Context, state IdentifyState, duration time.Duration) (err error)
+}
+
+type identifyGetterProvider struct {
+	name string
+	IdentifyGetter
+}
+
+type identifySetterProvider struct {
+	name string
+	IdentifySetter
+}
+
+func getIdentify(ctx context.Context, timeout time.Duration, p []identifyGetterProvider) (state IdentifyState, metadata Metadata, err error) {
+	var metadataLocal Metadata
+
+	for _, elem := range p {
+		if elem.IdentifyGetter == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return state, metadata, err
+		default:
+			metadataLocal.ProvidersAttempted = append(metadataLocal.ProvidersAttempted, elem.name)
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			start := time.Now()
+			var state IdentifyState
+			getErr := metadataLocal.retry(ctx, elem.name, "IdentifyGet", true, func() (err error) {
+				state, err = elem.IdentifyGet(ctx)
+				return err
+			})
+			metadataLocal.setProviderDuration(elem.name, time.Since(start))
+			if getErr != nil {
+				err = multierror.Append(err, metadataLocal.providerFailed(elem.name, "IdentifyGet", getErr))
+				continue
+			}
+
+			metadataLocal.SuccessfulProvider = elem.name
+			return state, metadataLocal, nil
+		}
+	}
+
+	return state, metadataLocal, multierror.Append(err, errors.New("failed to get identify state"))
+}
+
+// GetIdentifyFromInterfaces identifies implementations of the IdentifyGetter interface and passes them to the getIdentify() wrapper method.
+func GetIdentifyFromInterfaces(ctx context.Context, timeout time.Duration, generic []interface{}) (state IdentifyState, metadata Metadata, err error) {
+	getters := make([]identifyGetterProvider, 0)
+	for _, elem := range generic {
+		temp := identifyGetterProvider{name: getProviderName(elem)}
+		switch p := elem.(type) {
+		case IdentifyGetter:
+			temp.IdentifyGetter = p
+			getters = append(getters, temp)
+		default:
+			e := fmt.Sprintf("not an IdentifyGetter implementation: %T", p)
+			err = multierror.Append(err, errors.New(e))
+		}
+	}
+	if len(getters) == 0 {
+		return state, metadata, multierror.Append(err, errors.New("no IdentifyGetter implementations found"))
+	}
+
+	return getIdentify(ctx, timeout, getters)
+}
+
+func setIdentify(ctx context.Context, timeout time.Duration, state IdentifyState, duration time.Duration, p []identifySetterProvider) (metadata Metadata, err error) {
+	var metadataLocal Metadata
+
+	for _, elem := range p {
+		if elem.IdentifySetter == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return metadata, err
+		default:
+			metadataLocal.ProvidersAttempted = append(metadataLocal.ProvidersAttempted, elem.name)
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			start := time.Now()
+			setErr := metadataLocal.retry(ctx, elem.name, "IdentifySet", true, func() error {
+				return elem.IdentifySet(ctx, state, duration)
+			})
+			metadataLocal.setProviderDuration(elem.name, time.Since(start))
+			if setErr != nil {
+				err = multierror.Append(err, metadataLocal.providerFailed(elem.name, "IdentifySet", setErr))
+				continue
+			}
+
+			metadataLocal.SuccessfulProvider = elem.name
+			return metadataLocal, nil
+		}
+	}
+
+	return metadataLocal, multierror.Append(err, errors.New("failed to set identify state"))
+}
+
+// SetIdentifyFromInterfaces identifies implementations of the IdentifySetter interface and passes them to the setIdentify() wrapper method.
+func SetIdentifyFromInterfaces(ctx context.Context, timeout time.Duration, state IdentifyState, duration time.Duration, generic []interface{}) (metadata Metadata, err error) {
+	state, err = ParseIdentifyState(string(state))
+	if err != nil {
+		return metadata, err
+	}
+
+	if duration < 0 || (duration > 0 && state != IdentifyBlink) {
+		return metadata, fmt.Errorf("invalid identify duration %s for state %s", duration, state)
+	}
+
+	setters := make([]identifySetterProvider, 0)
+	for _, elem := range generic {
+		temp := identifySetterProvider{name: getProviderName(elem)}
+		switch p := elem.(type) {
+		case IdentifySetter:
+			temp.IdentifySetter = p
+			setters = append(setters, temp)
+		default:
+			e := fmt.Sprintf("not an IdentifySetter implementation: %T", p)
+			err = multierror.Append(err, errors.New(e))
+		}
+	}
+	if len(setters) == 0 {
+		return metadata, multierror.Append(err, errors.New("no IdentifySetter implementations found"))
+	}
+
+	return setIdentify(ctx, timeout, state, duration, setters)
+}
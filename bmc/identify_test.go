@@ -0,0 +1,84 @@
+package bmc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type identifyTester struct {
+	state    IdentifyState
+	duration time.Duration
+	err      error
+}
+
+func (i *identifyTester) IdentifyGet(ctx context.Context) (IdentifyState, error) {
+	return i.state, i.err
+}
+
+func (i *identifyTester) IdentifySet(ctx context.Context, state IdentifyState, duration time.Duration) error {
+	if i.err != nil {
+		return i.err
+	}
+
+	i.state, i.duration = state, duration
+
+	return nil
+}
+
+func (i *identifyTester) Name() string {
+	return "test provider"
+}
+
+func TestGetIdentifyFromInterfaces(t *testing.T) {
+	state, metadata, err := GetIdentifyFromInterfaces(
+		context.Background(),
+		time.Second,
+		[]interface{}{&identifyTester{err: errors.New("not supported")}, &identifyTester{state: IdentifyBlink}},
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, IdentifyBlink, state)
+	assert.Equal(t, []string{"test provider", "test provider"}, metadata.ProvidersAttempted)
+
+	_, _, err = GetIdentifyFromInterfaces(context.Background(), time.Second, []interface{}{"foo"})
+	assert.ErrorContains(t, err, "no IdentifyGetter implementations found")
+}
+
+func TestSetIdentifyFromInterfaces(t *testing.T) {
+	testCases := []struct {
+		name     string
+		state    IdentifyState
+		duration time.Duration
+		err      error
+		errMsg   string
+	}{
+		{name: "on", state: IdentifyOn},
+		{name: "off", state: IdentifyOff},
+		{name: "blink", state: IdentifyBlink},
+		{name: "blink with duration", state: IdentifyBlink, duration: 30 * time.Second},
+		{name: "parsed state", state: "Blinking", duration: time.Minute},
+		{name: "unknown state", state: "flash", errMsg: `unknown identify state: "flash"`},
+		{name: "duration for on", state: IdentifyOn, duration: time.Minute, errMsg: "invalid identify duration 1m0s for state on"},
+		{name: "provider fails", state: IdentifyOn, err: errors.New("not supported"), errMsg: "failed to set identify state"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			provider := &identifyTester{err: tc.err}
+
+			_, err := SetIdentifyFromInterfaces(context.Background(), time.Second, tc.state, tc.duration, []interface{}{provider})
+			if tc.errMsg != "" {
+				assert.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+
+			assert.Nil(t, err)
+			want, _ := ParseIdentifyState(string(tc.state))
+			assert.Equal(t, want, provider.state)
+			assert.Equal(t, tc.duration, provider.duration)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package bmc
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	common "github.com/metal-toolbox/bmc-common"
@@ -36,9 +37,15 @@ func inventory(ctx context.Context, generic []inventoryGetterProvider) (device *
 			return device, metadata, err
 		default:
 			metadataLocal.ProvidersAttempted = append(metadataLocal.ProvidersAttempted, elem.name)
-			device, vErr := elem.Inventory(ctx)
+			start := time.Now()
+			var device *common.Device
+			vErr := metadataLocal.retry(ctx, elem.name, "Inventory", true, func() (err error) {
+				device, err = elem.Inventory(ctx)
+				return err
+			})
+			metadataLocal.setProviderDuration(elem.name, time.Since(start))
 			if vErr != nil {
-				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				err = multierror.Append(err, metadataLocal.providerFailed(elem.name, "Inventory", vErr))
 				err = multierror.Append(err, vErr)
 				continue
 
@@ -77,5 +84,33 @@ func GetInventoryFromInterfaces(ctx context.Context, generic []interface{}) (dev
 		)
 	}
 
+	if strategy, _ := ExecutionStrategyFromContext(ctx); strategy.concurrent() {
+		calls := make([]providerCall[*common.Device], 0, len(implementations))
+		for _, elem := range implementations {
+			calls = append(calls, providerCall[*common.Device]{name: elem.name, call: elem.Inventory})
+		}
+
+		return runConcurrent(ctx, 0, strategy, "Inventory", calls, normalizeInventory, "failure to get device inventory")
+	}
+
 	return inventory(ctx, implementations)
 }
+
+// normalizeInventory returns a comparable representation of the device inventory, the serial number and the
+// BIOS and BMC firmware versions, the other details are reported differently by each provider.
+func normalizeInventory(device *common.Device) string {
+	if device == nil {
+		return ""
+	}
+
+	var bios, bmcFirmware string
+	if device.BIOS != nil && device.BIOS.Firmware != nil {
+		bios = device.BIOS.Firmware.Installed
+	}
+
+	if device.BMC != nil && device.BMC.Firmware != nil {
+		bmcFirmware = device.BMC.Firmware.Installed
+	}
+
+	return fmt.Sprintf("serial=%s bios=%s bmc=%s", device.Serial, bios, bmcFirmware)
+}
@@ -0,0 +1,151 @@
+package bmc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
+)
+
+// LogServiceOwner is the kind of resource a log service belongs to.
+type LogServiceOwner string
+
+const (
+	LogServiceOwnerSystem  LogServiceOwner = "system"
+	LogServiceOwnerManager LogServiceOwner = "manager"
+	LogServiceOwnerChassis LogServiceOwner = "chassis"
+)
+
+// LogServiceInfo describes one of the logs of a BMC, like the SEL, the Dell Lifecycle log or the OpenBMC EventLog.
+type LogServiceInfo struct {
+	// ID is the identifier of the log service within its owner, like Sel, Lclog or EventLog.
+	ID string
+	// Path uniquely identifies the log service, the Redfish @odata.id.
+	Path  string
+	Name  string
+	Owner LogServiceOwner
+	// OwnerID is the identifier of the owning resource, like System.Embedded.1 or iDRAC.Embedded.1.
+	OwnerID string
+	// EntryType is the type of the log entries, like SEL, Event, Multiple or OEM.
+	EntryType string
+	Enabled   bool
+}
+
+// LogService for services that expose several logs, the log is selected by its Path, or by its ID when
+// only one of the logs has that ID.
+type LogService interface {
+	LogServices(ctx context.Context) (services []LogServiceInfo, err error)
+	GetLogServiceEntries(ctx context.Context, id string) (entries []SystemEventLogEntry, err error)
+	ClearLogService(ctx context.Context, id string) (err error)
+}
+
+type logServiceProvider struct {
+	name string
+	LogService
+}
+
+// logServiceCall runs the call against each provider in turn until one succeeds.
+func logServiceCall[T any](ctx context.Context, timeout time.Duration, p []logServiceProvider, operation string, idempotent bool, call func(ctx context.Context, s LogService) (T, error), failMsg string) (result T, metadata Metadata, err error) {
+	var metadataLocal Metadata
+
+	for _, elem := range p {
+		if elem.LogService == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return result, metadata, err
+		default:
+			metadataLocal.ProvidersAttempted = append(metadataLocal.ProvidersAttempted, elem.name)
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			start := time.Now()
+			var result T
+			callErr := metadataLocal.retry(ctx, elem.name, operation, idempotent, func() (err error) {
+				result, err = call(ctx, elem.LogService)
+				return err
+			})
+			metadataLocal.setProviderDuration(elem.name, time.Since(start))
+			if callErr != nil {
+				err = multierror.Append(err, metadataLocal.providerFailed(elem.name, operation, callErr))
+				continue
+			}
+
+			metadataLocal.SuccessfulProvider = elem.name
+			return result, metadataLocal, nil
+		}
+	}
+
+	return result, metadataLocal, multierror.Append(err, errors.New(failMsg))
+}
+
+// logServiceProviders returns the LogService implementations
+func logServiceProviders(generic []interface{}) (services []logServiceProvider, err error) {
+	for _, elem := range generic {
+		temp := logServiceProvider{name: getProviderName(elem)}
+		switch p := elem.(type) {
+		case LogService:
+			temp.LogService = p
+			services = append(services, temp)
+		default:
+			e := fmt.Sprintf("not a LogService implementation: %T", p)
+			err = multierror.Append(err, errors.New(e))
+		}
+	}
+	if len(services) == 0 {
+		return nil, multierror.Append(err, errors.New("no LogService implementations found"))
+	}
+
+	return services, nil
+}
+
+// LogServicesFromInterfaces identifies implementations of the LogService interface and returns the log services of the first one that succeeds.
+func LogServicesFromInterfaces(ctx context.Context, timeout time.Duration, generic []interface{}) (services []LogServiceInfo, metadata Metadata, err error) {
+	providers, err := logServiceProviders(generic)
+	if err != nil {
+		return nil, metadata, err
+	}
+
+	return logServiceCall(ctx, timeout, providers, "LogServices", true, func(ctx context.Context, s LogService) ([]LogServiceInfo, error) {
+		return s.LogServices(ctx)
+	}, "failed to list log services")
+}
+
+// GetLogServiceEntriesFromInterfaces identifies implementations of the LogService interface and returns the entries of the log service with the given id.
+func GetLogServiceEntriesFromInterfaces(ctx context.Context, timeout time.Duration, id string, generic []interface{}) (entries []SystemEventLogEntry, metadata Metadata, err error) {
+	if id == "" {
+		return nil, metadata, errors.New("log service id is required")
+	}
+
+	providers, err := logServiceProviders(generic)
+	if err != nil {
+		return nil, metadata, err
+	}
+
+	return logServiceCall(ctx, timeout, providers, "GetLogServiceEntries", true, func(ctx context.Context, s LogService) ([]SystemEventLogEntry, error) {
+		return s.GetLogServiceEntries(ctx, id)
+	}, "failed to get log service entries")
+}
+
+// ClearLogServiceFromInterfaces identifies implementations of the LogService interface and clears the log service with the given id.
+func ClearLogServiceFromInterfaces(ctx context.Context, timeout time.Duration, id string, generic []interface{}) (metadata Metadata, err error) {
+	if id == "" {
+		return metadata, errors.New("log service id is required")
+	}
+
+	providers, err := logServiceProviders(generic)
+	if err != nil {
+		return metadata, err
+	}
+
+	_, metadata, err = logServiceCall(ctx, timeout, providers, "ClearLogService", true, func(ctx context.Context, s LogService) (struct{}, error) {
+		return struct{}{}, s.ClearLogService(ctx, id)
+	}, "failed to clear log service")
+
+	return metadata, err
+}
@@ -0,0 +1,123 @@
+package bmc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type logServiceTester struct {
+	services []LogServiceInfo
+	entries  map[string][]SystemEventLogEntry
+	cleared  string
+	err      error
+}
+
+func (l *logServiceTester) LogServices(ctx context.Context) ([]LogServiceInfo, error) {
+	return l.services, l.err
+}
+
+func (l *logServiceTester) GetLogServiceEntries(ctx context.Context, id string) ([]SystemEventLogEntry, error) {
+	if l.err != nil {
+		return nil, l.err
+	}
+
+	entries, ok := l.entries[id]
+	if !ok {
+		return nil, errors.New("log service not found")
+	}
+
+	return entries, nil
+}
+
+func (l *logServiceTester) ClearLogService(ctx context.Context, id string) error {
+	if l.err == nil {
+		l.cleared = id
+	}
+
+	return l.err
+}
+
+func (l *logServiceTester) Name() string {
+	return "test provider"
+}
+
+func TestLogServicesFromInterfaces(t *testing.T) {
+	services := []LogServiceInfo{
+		{ID: "Sel", Path: "/redfish/v1/Managers/iDRAC.Embedded.1/LogServices/Sel", Owner: LogServiceOwnerManager, EntryType: "SEL", Enabled: true},
+		{ID: "Lclog", Path: "/redfish/v1/Managers/iDRAC.Embedded.1/LogServices/Lclog", Owner: LogServiceOwnerManager, EntryType: "Event", Enabled: true},
+	}
+
+	testCases := []struct {
+		name      string
+		providers []interface{}
+		want      []LogServiceInfo
+		errMsg    string
+	}{
+		{
+			name:      "success",
+			providers: []interface{}{&logServiceTester{services: services}},
+			want:      services,
+		},
+		{
+			name:      "first provider fails",
+			providers: []interface{}{&logServiceTester{err: errors.New("no log services")}, &logServiceTester{services: services}},
+			want:      services,
+		},
+		{
+			name:      "all providers fail",
+			providers: []interface{}{&logServiceTester{err: errors.New("no log services")}},
+			errMsg:    "failed to list log services",
+		},
+		{
+			name:      "no implementations",
+			providers: []interface{}{"foo"},
+			errMsg:    "no LogService implementations found",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, metadata, err := LogServicesFromInterfaces(context.Background(), time.Second, tc.providers)
+			if tc.errMsg != "" {
+				assert.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, "test provider", metadata.SuccessfulProvider)
+		})
+	}
+}
+
+func TestGetLogServiceEntriesFromInterfaces(t *testing.T) {
+	entries := []SystemEventLogEntry{{ID: "1", Message: "The system was powered on"}}
+	tester := &logServiceTester{entries: map[string][]SystemEventLogEntry{"Lclog": entries}}
+
+	got, metadata, err := GetLogServiceEntriesFromInterfaces(context.Background(), time.Second, "Lclog", []interface{}{tester})
+	assert.Nil(t, err)
+	assert.Equal(t, entries, got)
+	assert.Equal(t, "test provider", metadata.SuccessfulProvider)
+
+	_, _, err = GetLogServiceEntriesFromInterfaces(context.Background(), time.Second, "Unknown", []interface{}{tester})
+	assert.ErrorContains(t, err, "failed to get log service entries")
+
+	_, _, err = GetLogServiceEntriesFromInterfaces(context.Background(), time.Second, "", []interface{}{tester})
+	assert.ErrorContains(t, err, "log service id is required")
+}
+
+func TestClearLogServiceFromInterfaces(t *testing.T) {
+	tester := &logServiceTester{}
+
+	metadata, err := ClearLogServiceFromInterfaces(context.Background(), time.Second, "EventLog", []interface{}{tester})
+	assert.Nil(t, err)
+	assert.Equal(t, "EventLog", tester.cleared)
+	assert.Equal(t, "test provider", metadata.SuccessfulProvider)
+
+	_, err = ClearLogServiceFromInterfaces(context.Background(), time.Second, "EventLog", []interface{}{&logServiceTester{err: errors.New("clear failed")}})
+	assert.ErrorContains(t, err, "failed to clear log service")
+}
@@ -20,10 +20,14 @@ func sendNMI(ctx context.Context, timeout time.Duration, sender NMISender, metad
 
 	metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, senderName)
 
-	err := sender.SendNMI(ctx)
+	start := time.Now()
+	err := metadata.retry(ctx, senderName, "SendNMI", false, func() error {
+		return sender.SendNMI(ctx)
+	})
+	metadata.setProviderDuration(senderName, time.Since(start))
 	if err != nil {
 		metadata.FailedProviderDetail[senderName] = err.Error()
-		return err
+		return metadata.providerFailed(senderName, "SendNMI", err)
 	}
 
 	metadata.SuccessfulProvider = senderName
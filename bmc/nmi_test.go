@@ -118,6 +118,19 @@ func TestSendNMIFromInterface(t *testing.T) {
 				assert.ErrorContains(t, err, tt.errMsg)
 			}
 
+			// durations vary between runs, only the providers they are recorded for are compared
+			for _, name := range metadata.ProvidersAttempted {
+				assert.Contains(t, metadata.ProviderDurations, name)
+			}
+			metadata.ProviderDurations = nil
+
+			// failed providers are recorded with the operation they failed
+			for name, pErr := range metadata.FailedProviderErrors {
+				assert.Contains(t, tt.expectedMetadata.FailedProviderDetail, name)
+				assert.Equal(t, "SendNMI", pErr.Operation)
+			}
+			metadata.FailedProviderErrors = nil
+
 			assert.Equal(t, tt.expectedMetadata, metadata)
 		})
 	}
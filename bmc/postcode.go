@@ -3,6 +3,8 @@ package bmc
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	bmclibErrs "github.com/metal-toolbox/bmclib/errors"
@@ -23,6 +25,22 @@ type postCodeGetterProvider struct {
 	PostCodeGetter
 }
 
+// postCodeResult groups the PostCode return values for concurrent execution.
+type postCodeResult struct {
+	status string
+	code   int
+}
+
+func (p postCodeGetterProvider) postCodeResult(ctx context.Context) (postCodeResult, error) {
+	status, code, err := p.PostCode(ctx)
+	return postCodeResult{status: status, code: code}, err
+}
+
+// normalizePostCode returns a comparable representation of a POST code result.
+func normalizePostCode(result postCodeResult) string {
+	return fmt.Sprintf("%s:%d", strings.ToLower(result.status), result.code)
+}
+
 // postCode returns the device BIOS/UEFI POST code
 func postCode(ctx context.Context, generic []postCodeGetterProvider) (status string, code int, metadata Metadata, err error) {
 	var metadataLocal Metadata
@@ -38,9 +56,16 @@ func postCode(ctx context.Context, generic []postCodeGetterProvider) (status str
 			return status, code, metadata, err
 		default:
 			metadataLocal.ProvidersAttempted = append(metadataLocal.ProvidersAttempted, elem.name)
-			status, code, vErr := elem.PostCode(ctx)
+			start := time.Now()
+			var status string
+			var code int
+			vErr := metadataLocal.retry(ctx, elem.name, "PostCode", true, func() (err error) {
+				status, code, err = elem.PostCode(ctx)
+				return err
+			})
+			metadataLocal.setProviderDuration(elem.name, time.Since(start))
 			if vErr != nil {
-				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				err = multierror.Append(err, metadataLocal.providerFailed(elem.name, "PostCode", vErr))
 				err = multierror.Append(err, vErr)
 				continue
 
@@ -77,5 +102,15 @@ func GetPostCodeInterfaces(ctx context.Context, generic []interface{}) (status s
 		)
 	}
 
+	if strategy, _ := ExecutionStrategyFromContext(ctx); strategy.concurrent() {
+		calls := make([]providerCall[postCodeResult], 0, len(implementations))
+		for _, elem := range implementations {
+			calls = append(calls, providerCall[postCodeResult]{name: elem.name, call: elem.postCodeResult})
+		}
+
+		result, metadata, err := runConcurrent(ctx, 0, strategy, "PostCode", calls, normalizePostCode, "failure to get device POST code")
+		return result.status, result.code, metadata, err
+	}
+
 	return postCode(ctx, implementations)
 }
@@ -3,12 +3,66 @@ package bmc
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/pkg/errors"
 )
 
+// PowerState is the power state of a machine, as normalized from the states returned by the providers.
+type PowerState string
+
+const (
+	PowerStateOn          PowerState = "on"
+	PowerStateOff         PowerState = "off"
+	PowerStatePoweringOn  PowerState = "poweringon"
+	PowerStatePoweringOff PowerState = "poweringoff"
+	// PowerStateUnknown is returned for states that could not be normalized, like the Redfish Paused state.
+	PowerStateUnknown PowerState = "unknown"
+)
+
+// ParsePowerState normalizes the power state returned by a provider,
+// for example "On" (Redfish), "Chassis Power is on" (ipmitool) and "on" are all PowerStateOn.
+func ParsePowerState(state string) PowerState {
+	state = strings.ToLower(strings.TrimSpace(state))
+	state = strings.TrimPrefix(state, "chassis power is ")
+	state = strings.NewReplacer(" ", "", "-", "", "_", "").Replace(state)
+
+	switch PowerState(state) {
+	case PowerStateOn, PowerStateOff, PowerStatePoweringOn, PowerStatePoweringOff:
+		return PowerState(state)
+	default:
+		return PowerStateUnknown
+	}
+}
+
+// PowerAction is a power action accepted by PowerSetter implementations.
+type PowerAction string
+
+const (
+	// PowerActionOn powers up the chassis.
+	PowerActionOn PowerAction = "on"
+	// PowerActionOff hard powers down the chassis.
+	PowerActionOff PowerAction = "off"
+	// PowerActionSoft initiates a soft-shutdown of the OS via ACPI.
+	PowerActionSoft PowerAction = "soft"
+	// PowerActionReset soft powers down and then powers on the chassis.
+	PowerActionReset PowerAction = "reset"
+	// PowerActionCycle hard powers down and then powers on the chassis.
+	PowerActionCycle PowerAction = "cycle"
+)
+
+// ParsePowerAction returns the PowerAction for the given action, the action is case insensitive.
+func ParsePowerAction(action string) (PowerAction, error) {
+	switch a := PowerAction(strings.ToLower(strings.TrimSpace(action))); a {
+	case PowerActionOn, PowerActionOff, PowerActionSoft, PowerActionReset, PowerActionCycle:
+		return a, nil
+	default:
+		return "", fmt.Errorf("unknown power action: %q", action)
+	}
+}
+
 // PowerSetter sets the power state of a BMC
 type PowerSetter interface {
 	// PowerSet sets the power state of a Machine through a BMC.
@@ -37,6 +91,17 @@ type powerProviders struct {
 	powerSetter      PowerSetter
 }
 
+// powerSetIdempotent returns true for power states that can be set repeatedly with the same outcome,
+// a repeated cycle or reset would restart the machine again.
+func powerSetIdempotent(state string) bool {
+	switch strings.ToLower(state) {
+	case "on", "off":
+		return true
+	default:
+		return false
+	}
+}
+
 // setPowerState sets the power state for a BMC, trying all interface implementations passed in
 func setPowerState(ctx context.Context, timeout time.Duration, state string, p []powerProviders) (ok bool, m Metadata, err error) {
 	metadataLocal := Metadata{
@@ -56,9 +121,15 @@ func setPowerState(ctx context.Context, timeout time.Duration, state string, p [
 			metadataLocal.ProvidersAttempted = append(metadataLocal.ProvidersAttempted, elem.name)
 			ctx, cancel := context.WithTimeout(ctx, timeout)
 			defer cancel()
-			ok, setErr := elem.powerSetter.PowerSet(ctx, state)
+			start := time.Now()
+			var ok bool
+			setErr := metadataLocal.retry(ctx, elem.name, "PowerSet", powerSetIdempotent(state), func() (err error) {
+				ok, err = elem.powerSetter.PowerSet(ctx, state)
+				return err
+			})
+			metadataLocal.setProviderDuration(elem.name, time.Since(start))
 			if setErr != nil {
-				err = multierror.Append(err, errors.WithMessagef(setErr, "provider: %v", elem.name))
+				err = multierror.Append(err, metadataLocal.providerFailed(elem.name, "PowerSet", setErr))
 				metadataLocal.FailedProviderDetail[elem.name] = setErr.Error()
 				continue
 			}
@@ -74,9 +145,15 @@ func setPowerState(ctx context.Context, timeout time.Duration, state string, p [
 }
 
 // SetPowerStateFromInterfaces identifies implementations of the PostStateSetter interface and passes the found implementations to the setPowerState() wrapper.
+// The state is normalized with ParsePowerAction before it is passed to the providers.
 func SetPowerStateFromInterfaces(ctx context.Context, timeout time.Duration, state string, generic []interface{}) (ok bool, metadata Metadata, err error) {
 	metadata = newMetadata()
 
+	action, err := ParsePowerAction(state)
+	if err != nil {
+		return false, metadata, err
+	}
+
 	powerSetter := make([]powerProviders, 0)
 	for _, elem := range generic {
 		temp := powerProviders{name: getProviderName(elem)}
@@ -92,7 +169,13 @@ func SetPowerStateFromInterfaces(ctx context.Context, timeout time.Duration, sta
 	if len(powerSetter) == 0 {
 		return ok, metadata, multierror.Append(err, errors.New("no PowerSetter implementations found"))
 	}
-	return setPowerState(ctx, timeout, state, powerSetter)
+	return setPowerState(ctx, timeout, string(action), powerSetter)
+}
+
+// normalizePowerState returns the power state returned by a provider normalized with ParsePowerState,
+// it is used to compare the states returned by the providers with the ExecutionConsensus strategy.
+func normalizePowerState(state string) string {
+	return string(ParsePowerState(state))
 }
 
 // getPowerState gets the power state for a BMC, trying all interface implementations passed in
@@ -113,9 +196,15 @@ func getPowerState(ctx context.Context, timeout time.Duration, p []powerProvider
 			metadataLocal.ProvidersAttempted = append(metadataLocal.ProvidersAttempted, elem.name)
 			ctx, cancel := context.WithTimeout(ctx, timeout)
 			defer cancel()
-			state, stateErr := elem.powerStateGetter.PowerStateGet(ctx)
+			start := time.Now()
+			var state string
+			stateErr := metadataLocal.retry(ctx, elem.name, "PowerStateGet", true, func() (err error) {
+				state, err = elem.powerStateGetter.PowerStateGet(ctx)
+				return err
+			})
+			metadataLocal.setProviderDuration(elem.name, time.Since(start))
 			if stateErr != nil {
-				err = multierror.Append(err, errors.WithMessagef(stateErr, "provider: %v", elem.name))
+				err = multierror.Append(err, metadataLocal.providerFailed(elem.name, "PowerStateGet", stateErr))
 				metadataLocal.FailedProviderDetail[elem.name] = stateErr.Error()
 				continue
 			}
@@ -127,6 +216,7 @@ func getPowerState(ctx context.Context, timeout time.Duration, p []powerProvider
 }
 
 // GetPowerStateFromInterfaces identifies implementations of the PostStateGetter interface and passes the found implementations to the getPowerState() wrapper.
+// The power state is returned as reported by the provider, see PowerStateFromInterfaces for the normalized state.
 func GetPowerStateFromInterfaces(ctx context.Context, timeout time.Duration, generic []interface{}) (state string, metadata Metadata, err error) {
 	metadata = newMetadata()
 
@@ -145,5 +235,26 @@ func GetPowerStateFromInterfaces(ctx context.Context, timeout time.Duration, gen
 	if len(powerStateGetter) == 0 {
 		return state, metadata, multierror.Append(err, errors.New("no PowerStateGetter implementations found"))
 	}
+
+	if strategy, _ := ExecutionStrategyFromContext(ctx); strategy.concurrent() {
+		calls := make([]providerCall[string], 0, len(powerStateGetter))
+		for _, elem := range powerStateGetter {
+			calls = append(calls, providerCall[string]{name: elem.name, call: elem.powerStateGetter.PowerStateGet})
+		}
+
+		return runConcurrent(ctx, timeout, strategy, "PowerStateGet", calls, normalizePowerState, "failed to get power state")
+	}
+
 	return getPowerState(ctx, timeout, powerStateGetter)
 }
+
+// PowerStateFromInterfaces is GetPowerStateFromInterfaces with the power state normalized with ParsePowerState,
+// PowerStateUnknown is returned on error.
+func PowerStateFromInterfaces(ctx context.Context, timeout time.Duration, generic []interface{}) (state PowerState, metadata Metadata, err error) {
+	raw, metadata, err := GetPowerStateFromInterfaces(ctx, timeout, generic)
+	if err != nil {
+		return PowerStateUnknown, metadata, err
+	}
+
+	return ParsePowerState(raw), metadata, nil
+}
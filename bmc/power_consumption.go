@@ -0,0 +1,165 @@
+package bmc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
+)
+
+// PowerReading is the power consumption of a machine as reported by its BMC, in watts.
+type PowerReading struct {
+	// Watts is the current power consumption.
+	Watts float64
+	// MinWatts, MaxWatts and AverageWatts are the power consumption statistics over the Interval,
+	// they are left at zero when not reported by the BMC.
+	MinWatts     float64
+	MaxWatts     float64
+	AverageWatts float64
+	Interval     time.Duration
+	// CapWatts is the configured power limit, zero when no limit is configured.
+	CapWatts float64
+	// CapEnabled is set when the power limit is enforced.
+	CapEnabled bool
+}
+
+// PowerReader reads the power consumption of a machine.
+type PowerReader interface {
+	PowerRead(ctx context.Context) (reading PowerReading, err error)
+}
+
+// PowerCapSetter sets the power limit of a machine.
+type PowerCapSetter interface {
+	// PowerCapSet sets and enforces the power limit in watts, a limit of zero removes the power limit.
+	PowerCapSet(ctx context.Context, watts int) (err error)
+}
+
+type powerReaderProvider struct {
+	name string
+	PowerReader
+}
+
+type powerCapSetterProvider struct {
+	name string
+	PowerCapSetter
+}
+
+func powerRead(ctx context.Context, timeout time.Duration, p []powerReaderProvider) (reading PowerReading, metadata Metadata, err error) {
+	var metadataLocal Metadata
+
+	for _, elem := range p {
+		if elem.PowerReader == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return reading, metadata, err
+		default:
+			metadataLocal.ProvidersAttempted = append(metadataLocal.ProvidersAttempted, elem.name)
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			start := time.Now()
+			var reading PowerReading
+			readErr := metadataLocal.retry(ctx, elem.name, "PowerRead", true, func() (err error) {
+				reading, err = elem.PowerRead(ctx)
+				return err
+			})
+			metadataLocal.setProviderDuration(elem.name, time.Since(start))
+			if readErr != nil {
+				err = multierror.Append(err, metadataLocal.providerFailed(elem.name, "PowerRead", readErr))
+				continue
+			}
+
+			metadataLocal.SuccessfulProvider = elem.name
+			return reading, metadataLocal, nil
+		}
+	}
+
+	return reading, metadataLocal, multierror.Append(err, errors.New("failed to read power consumption"))
+}
+
+// PowerReadFromInterfaces identifies implementations of the PowerReader interface and passes them to the powerRead() wrapper method.
+func PowerReadFromInterfaces(ctx context.Context, timeout time.Duration, generic []interface{}) (reading PowerReading, metadata Metadata, err error) {
+	readers := make([]powerReaderProvider, 0)
+	for _, elem := range generic {
+		temp := powerReaderProvider{name: getProviderName(elem)}
+		switch p := elem.(type) {
+		case PowerReader:
+			temp.PowerReader = p
+			readers = append(readers, temp)
+		default:
+			e := fmt.Sprintf("not a PowerReader implementation: %T", p)
+			err = multierror.Append(err, errors.New(e))
+		}
+	}
+	if len(readers) == 0 {
+		return reading, metadata, multierror.Append(err, errors.New("no PowerReader implementations found"))
+	}
+
+	return powerRead(ctx, timeout, readers)
+}
+
+func powerCapSet(ctx context.Context, timeout time.Duration, watts int, p []powerCapSetterProvider) (metadata Metadata, err error) {
+	var metadataLocal Metadata
+
+	for _, elem := range p {
+		if elem.PowerCapSetter == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return metadata, err
+		default:
+			metadataLocal.ProvidersAttempted = append(metadataLocal.ProvidersAttempted, elem.name)
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			start := time.Now()
+			setErr := metadataLocal.retry(ctx, elem.name, "PowerCapSet", true, func() error {
+				return elem.PowerCapSet(ctx, watts)
+			})
+			metadataLocal.setProviderDuration(elem.name, time.Since(start))
+			if setErr != nil {
+				err = multierror.Append(err, metadataLocal.providerFailed(elem.name, "PowerCapSet", setErr))
+				continue
+			}
+
+			metadataLocal.SuccessfulProvider = elem.name
+			return metadataLocal, nil
+		}
+	}
+
+	return metadataLocal, multierror.Append(err, errors.New("failed to set power cap"))
+}
+
+// PowerCapSetFromInterfaces identifies implementations of the PowerCapSetter interface and passes them to the powerCapSet() wrapper method.
+func PowerCapSetFromInterfaces(ctx context.Context, timeout time.Duration, watts int, generic []interface{}) (metadata Metadata, err error) {
+	if watts < 0 {
+		return metadata, fmt.Errorf("invalid power cap: %d watts", watts)
+	}
+
+	setters := make([]powerCapSetterProvider, 0)
+	for _, elem := range generic {
+		temp := powerCapSetterProvider{name: getProviderName(elem)}
+		switch p := elem.(type) {
+		case PowerCapSetter:
+			temp.PowerCapSetter = p
+			setters = append(setters, temp)
+		default:
+			e := fmt.Sprintf("not a PowerCapSetter implementation: %T", p)
+			err = multierror.Append(err, errors.New(e))
+		}
+	}
+	if len(setters) == 0 {
+		return metadata, multierror.Append(err, errors.New("no PowerCapSetter implementations found"))
+	}
+
+	return powerCapSet(ctx, timeout, watts, setters)
+}
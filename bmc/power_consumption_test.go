@@ -0,0 +1,126 @@
+package bmc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type powerConsumptionTester struct {
+	reading PowerReading
+	watts   int
+	err     error
+}
+
+func (p *powerConsumptionTester) PowerRead(ctx context.Context) (PowerReading, error) {
+	return p.reading, p.err
+}
+
+func (p *powerConsumptionTester) PowerCapSet(ctx context.Context, watts int) error {
+	if p.err != nil {
+		return p.err
+	}
+
+	p.watts = watts
+
+	return nil
+}
+
+func (p *powerConsumptionTester) Name() string {
+	return "test provider"
+}
+
+func TestPowerReadFromInterfaces(t *testing.T) {
+	reading := PowerReading{Watts: 220, MinWatts: 40, MaxWatts: 360, AverageWatts: 222, Interval: time.Second, CapWatts: 400, CapEnabled: true}
+
+	testCases := []struct {
+		name      string
+		providers []interface{}
+		want      PowerReading
+		errMsg    string
+	}{
+		{
+			name:      "success",
+			providers: []interface{}{&powerConsumptionTester{reading: reading}},
+			want:      reading,
+		},
+		{
+			name:      "first provider fails",
+			providers: []interface{}{&powerConsumptionTester{err: errors.New("dcmi not supported")}, &powerConsumptionTester{reading: reading}},
+			want:      reading,
+		},
+		{
+			name:      "all providers fail",
+			providers: []interface{}{&powerConsumptionTester{err: errors.New("dcmi not supported")}},
+			errMsg:    "failed to read power consumption",
+		},
+		{
+			name:      "no implementations",
+			providers: []interface{}{"foo"},
+			errMsg:    "no PowerReader implementations found",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, metadata, err := PowerReadFromInterfaces(context.Background(), time.Second, tc.providers)
+			if tc.errMsg != "" {
+				assert.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, "test provider", metadata.SuccessfulProvider)
+		})
+	}
+}
+
+func TestPowerCapSetFromInterfaces(t *testing.T) {
+	testCases := []struct {
+		name     string
+		watts    int
+		provider *powerConsumptionTester
+		errMsg   string
+	}{
+		{
+			name:     "success",
+			watts:    400,
+			provider: &powerConsumptionTester{},
+		},
+		{
+			name:     "remove cap",
+			watts:    0,
+			provider: &powerConsumptionTester{watts: 400},
+		},
+		{
+			name:     "negative cap",
+			watts:    -1,
+			provider: &powerConsumptionTester{},
+			errMsg:   "invalid power cap",
+		},
+		{
+			name:     "provider fails",
+			watts:    400,
+			provider: &powerConsumptionTester{err: errors.New("limit out of range")},
+			errMsg:   "failed to set power cap",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			metadata, err := PowerCapSetFromInterfaces(context.Background(), time.Second, tc.watts, []interface{}{tc.provider})
+			if tc.errMsg != "" {
+				assert.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.watts, tc.provider.watts)
+			assert.Equal(t, "test provider", metadata.SuccessfulProvider)
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package bmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
+)
+
+// PowerRestorePolicy is the power state a machine returns to when AC power is restored after a power loss.
+type PowerRestorePolicy string
+
+const (
+	// PowerRestoreAlwaysOn powers on the machine when power is restored.
+	PowerRestoreAlwaysOn PowerRestorePolicy = "AlwaysOn"
+	// PowerRestoreAlwaysOff leaves the machine powered off when power is restored.
+	PowerRestoreAlwaysOff PowerRestorePolicy = "AlwaysOff"
+	// PowerRestoreLastState returns the machine to the power state it was in when power was lost.
+	PowerRestoreLastState PowerRestorePolicy = "LastState"
+)
+
+// ParsePowerRestorePolicy returns the PowerRestorePolicy for the given policy,
+// the Redfish names and the ipmitool names like always-on and previous are accepted.
+func ParsePowerRestorePolicy(policy string) (PowerRestorePolicy, error) {
+	switch strings.ToLower(strings.TrimSpace(policy)) {
+	case "alwayson", "always-on":
+		return PowerRestoreAlwaysOn, nil
+	case "alwaysoff", "always-off":
+		return PowerRestoreAlwaysOff, nil
+	case "laststate", "last-state", "previous":
+		return PowerRestoreLastState, nil
+	default:
+		return "", fmt.Errorf("unknown power restore policy: %q", policy)
+	}
+}
+
+// PowerRestorePolicyGetter gets the power restore policy of a machine
+type PowerRestorePolicyGetter interface {
+	PowerRestorePolicyGet(ctx context.Context) (policy PowerRestorePolicy, err error)
+}
+
+// PowerRestorePolicySetter sets the power restore policy of a machine
+type PowerRestorePolicySetter interface {
+	PowerRestorePolicySet(ctx context.Context, policy PowerRestorePolicy) (err error)
+}
+
+type powerRestorePolicyGetterProvider struct {
+	name string
+	PowerRestorePolicyGetter
+}
+
+type powerRestorePolicySetterProvider struct {
+	name string
+	PowerRestorePolicySetter
+}
+
+func getPowerRestorePolicy(ctx context.Context, timeout time.Duration, p []powerRestorePolicyGetterProvider) (policy PowerRestorePolicy, metadata Metadata, err error) {
+	var metadataLocal Metadata
+
+	for _, elem := range p {
+		if elem.PowerRestorePolicyGetter == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return policy, metadata, err
+		default:
+			metadataLocal.ProvidersAttempted = append(metadataLocal.ProvidersAttempted, elem.name)
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			start := time.Now()
+			var policy PowerRestorePolicy
+			getErr := metadataLocal.retry(ctx, elem.name, "PowerRestorePolicyGet", true, func() (err error) {
+				policy, err = elem.PowerRestorePolicyGet(ctx)
+				return err
+			})
+			metadataLocal.setProviderDuration(elem.name, time.Since(start))
+			if getErr != nil {
+				err = multierror.Append(err, metadataLocal.providerFailed(elem.name, "PowerRestorePolicyGet", getErr))
+				continue
+			}
+
+			metadataLocal.SuccessfulProvider = elem.name
+			return policy, metadataLocal, nil
+		}
+	}
+
+	return policy, metadataLocal, multierror.Append(err, errors.New("failed to get power restore policy"))
+}
+
+// GetPowerRestorePolicyFromInterfaces identifies implementations of the PowerRestorePolicyGetter interface and passes them to the getPowerRestorePolicy() wrapper method.
+func GetPowerRestorePolicyFromInterfaces(ctx context.Context, timeout time.Duration, generic []interface{}) (policy PowerRestorePolicy, metadata Metadata, err error) {
+	getters := make([]powerRestorePolicyGetterProvider, 0)
+	for _, elem := range generic {
+		temp := powerRestorePolicyGetterProvider{name: getProviderName(elem)}
+		switch p := elem.(type) {
+		case PowerRestorePolicyGetter:
+			temp.PowerRestorePolicyGetter = p
+			getters = append(getters, temp)
+		default:
+			e := fmt.Sprintf("not a PowerRestorePolicyGetter implementation: %T", p)
+			err = multierror.Append(err, errors.New(e))
+		}
+	}
+	if len(getters) == 0 {
+		return policy, metadata, multierror.Append(err, errors.New("no PowerRestorePolicyGetter implementations found"))
+	}
+
+	return getPowerRestorePolicy(ctx, timeout, getters)
+}
+
+func setPowerRestorePolicy(ctx context.Context, timeout time.Duration, policy PowerRestorePolicy, p []powerRestorePolicySetterProvider) (metadata Metadata, err error) {
+	var metadataLocal Metadata
+
+	for _, elem := range p {
+		if elem.PowerRestorePolicySetter == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return metadata, err
+		default:
+			metadataLocal.ProvidersAttempted = append(metadataLocal.ProvidersAttempted, elem.name)
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			start := time.Now()
+			setErr := metadataLocal.retry(ctx, elem.name, "PowerRestorePolicySet", true, func() error {
+				return elem.PowerRestorePolicySet(ctx, policy)
+			})
+			metadataLocal.setProviderDuration(elem.name, time.Since(start))
+			if setErr != nil {
+				err = multierror.Append(err, metadataLocal.providerFailed(elem.name, "PowerRestorePolicySet", setErr))
+				continue
+			}
+
+			metadataLocal.SuccessfulProvider = elem.name
+			return metadataLocal, nil
+		}
+	}
+
+	return metadataLocal, multierror.Append(err, errors.New("failed to set power restore policy"))
+}
+
+// SetPowerRestorePolicyFromInterfaces identifies implementations of the PowerRestorePolicySetter interface and passes them to the setPowerRestorePolicy() wrapper method.
+func SetPowerRestorePolicyFromInterfaces(ctx context.Context, timeout time.Duration, policy PowerRestorePolicy, generic []interface{}) (metadata Metadata, err error) {
+	policy, err = ParsePowerRestorePolicy(string(policy))
+	if err != nil {
+		return metadata, err
+	}
+
+	setters := make([]powerRestorePolicySetterProvider, 0)
+	for _, elem := range generic {
+		temp := powerRestorePolicySetterProvider{name: getProviderName(elem)}
+		switch p := elem.(type) {
+		case PowerRestorePolicySetter:
+			temp.PowerRestorePolicySetter = p
+			setters = append(setters, temp)
+		default:
+			e := fmt.Sprintf("not a PowerRestorePolicySetter implementation: %T", p)
+			err = multierror.Append(err, errors.New(e))
+		}
+	}
+	if len(setters) == 0 {
+		return metadata, multierror.Append(err, errors.New("no PowerRestorePolicySetter implementations found"))
+	}
+
+	return setPowerRestorePolicy(ctx, timeout, policy, setters)
+}
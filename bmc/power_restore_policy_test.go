@@ -0,0 +1,84 @@
+package bmc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type powerRestorePolicyTester struct {
+	policy PowerRestorePolicy
+	err    error
+}
+
+func (p *powerRestorePolicyTester) PowerRestorePolicyGet(ctx context.Context) (PowerRestorePolicy, error) {
+	return p.policy, p.err
+}
+
+func (p *powerRestorePolicyTester) PowerRestorePolicySet(ctx context.Context, policy PowerRestorePolicy) error {
+	if p.err != nil {
+		return p.err
+	}
+
+	p.policy = policy
+
+	return nil
+}
+
+func (p *powerRestorePolicyTester) Name() string {
+	return "test provider"
+}
+
+func TestParsePowerRestorePolicy(t *testing.T) {
+	testCases := map[string]PowerRestorePolicy{
+		"AlwaysOn":   PowerRestoreAlwaysOn,
+		"always-on":  PowerRestoreAlwaysOn,
+		"alwaysoff":  PowerRestoreAlwaysOff,
+		"always-off": PowerRestoreAlwaysOff,
+		"LastState":  PowerRestoreLastState,
+		"previous":   PowerRestoreLastState,
+	}
+
+	for policy, want := range testCases {
+		got, err := ParsePowerRestorePolicy(policy)
+		assert.Nil(t, err, policy)
+		assert.Equal(t, want, got, policy)
+	}
+
+	_, err := ParsePowerRestorePolicy("unknown")
+	assert.EqualError(t, err, `unknown power restore policy: "unknown"`)
+}
+
+func TestGetPowerRestorePolicyFromInterfaces(t *testing.T) {
+	failing := &powerRestorePolicyTester{err: errors.New("chassis device not supported")}
+	working := &powerRestorePolicyTester{policy: PowerRestoreLastState}
+
+	policy, metadata, err := GetPowerRestorePolicyFromInterfaces(context.Background(), time.Second, []interface{}{failing, working})
+	assert.Nil(t, err)
+	assert.Equal(t, PowerRestoreLastState, policy)
+	assert.Equal(t, []string{"test provider", "test provider"}, metadata.ProvidersAttempted)
+
+	_, _, err = GetPowerRestorePolicyFromInterfaces(context.Background(), time.Second, []interface{}{failing})
+	assert.ErrorContains(t, err, "failed to get power restore policy")
+
+	_, _, err = GetPowerRestorePolicyFromInterfaces(context.Background(), time.Second, []interface{}{"foo"})
+	assert.ErrorContains(t, err, "no PowerRestorePolicyGetter implementations found")
+}
+
+func TestSetPowerRestorePolicyFromInterfaces(t *testing.T) {
+	provider := &powerRestorePolicyTester{}
+
+	metadata, err := SetPowerRestorePolicyFromInterfaces(context.Background(), time.Second, "always-on", []interface{}{provider})
+	assert.Nil(t, err)
+	assert.Equal(t, PowerRestoreAlwaysOn, provider.policy)
+	assert.Equal(t, "test provider", metadata.SuccessfulProvider)
+
+	_, err = SetPowerRestorePolicyFromInterfaces(context.Background(), time.Second, "sometimes", []interface{}{provider})
+	assert.EqualError(t, err, `unknown power restore policy: "sometimes"`)
+
+	_, err = SetPowerRestorePolicyFromInterfaces(context.Background(), time.Second, PowerRestoreAlwaysOff, []interface{}{&powerRestorePolicyTester{err: errors.New("read only")}})
+	assert.ErrorContains(t, err, "failed to set power restore policy")
+}
@@ -8,6 +8,9 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-multierror"
+	"github.com/stretchr/testify/assert"
+
+	bmclibErrs "github.com/metal-toolbox/bmclib/errors"
 )
 
 type powerTester struct {
@@ -120,6 +123,7 @@ func TestSetPowerStateFromInterfaces(t *testing.T) {
 				if diff := cmp.Diff(metadata.SuccessfulProvider, "test provider"); diff != "" {
 					t.Fatal(diff)
 				}
+				assert.Contains(t, metadata.ProviderDurations, "test provider")
 			}
 		})
 	}
@@ -210,3 +214,101 @@ func TestGetPowerStateFromInterfaces(t *testing.T) {
 		})
 	}
 }
+
+type powerStateErrorTester struct {
+	err error
+}
+
+func (p *powerStateErrorTester) PowerStateGet(ctx context.Context) (state string, err error) {
+	return "", p.err
+}
+
+func (p *powerStateErrorTester) Name() string {
+	return "error tester"
+}
+
+func TestGetPowerStateProviderError(t *testing.T) {
+	testCases := map[string]struct {
+		providerErr    error
+		wantClass      bmclibErrs.ErrorClass
+		wantStatusCode int
+	}{
+		"http status":   {providerErr: bmclibErrs.NewHTTPError(503, errors.New("unavailable")), wantClass: bmclibErrs.ErrorClassBusy, wantStatusCode: 503},
+		"auth sentinel": {providerErr: bmclibErrs.ErrNotAuthenticated, wantClass: bmclibErrs.ErrorClassAuth},
+		"unclassified":  {providerErr: errors.New("boom"), wantClass: bmclibErrs.ErrorClassUnknown},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			generic := []interface{}{&powerStateErrorTester{err: tc.providerErr}}
+			_, metadata, err := GetPowerStateFromInterfaces(context.Background(), time.Second, generic)
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			var pErr *bmclibErrs.ProviderError
+			if !errors.As(err, &pErr) {
+				t.Fatalf("expected a ProviderError in the error chain: %v", err)
+			}
+
+			want := &bmclibErrs.ProviderError{
+				Provider:   "error tester",
+				Operation:  "PowerStateGet",
+				StatusCode: tc.wantStatusCode,
+				Class:      tc.wantClass,
+				Err:        tc.providerErr,
+			}
+
+			assert.Equal(t, want, pErr)
+			assert.Equal(t, want, metadata.FailedProviderErrors["error tester"])
+		})
+	}
+}
+
+func TestParsePowerState(t *testing.T) {
+	testCases := map[string]PowerState{
+		"on":                    PowerStateOn,
+		"On":                    PowerStateOn,
+		"Chassis Power is on\n": PowerStateOn,
+		"Off":                   PowerStateOff,
+		"PoweringOn":            PowerStatePoweringOn,
+		"powering off":          PowerStatePoweringOff,
+		"Paused":                PowerStateUnknown,
+		"":                      PowerStateUnknown,
+	}
+
+	for state, want := range testCases {
+		t.Run(state, func(t *testing.T) {
+			assert.Equal(t, want, ParsePowerState(state))
+		})
+	}
+}
+
+func TestPowerStateFromInterfaces(t *testing.T) {
+	generic := []interface{}{&delayedPowerStateGetter{name: "ipmitool", state: "Chassis Power is on"}}
+
+	raw, _, err := GetPowerStateFromInterfaces(context.Background(), time.Second, generic)
+	assert.Nil(t, err)
+	assert.Equal(t, "Chassis Power is on", raw)
+
+	state, metadata, err := PowerStateFromInterfaces(context.Background(), time.Second, generic)
+	assert.Nil(t, err)
+	assert.Equal(t, PowerStateOn, state)
+	assert.Equal(t, "ipmitool", metadata.SuccessfulProvider)
+
+	state, _, err = PowerStateFromInterfaces(context.Background(), time.Second, []interface{}{&delayedPowerStateGetter{name: "ipmitool", err: errors.New("boom")}})
+	assert.NotNil(t, err)
+	assert.Equal(t, PowerStateUnknown, state)
+}
+
+func TestParsePowerAction(t *testing.T) {
+	action, err := ParsePowerAction(" Cycle")
+	assert.Nil(t, err)
+	assert.Equal(t, PowerActionCycle, action)
+
+	_, err = ParsePowerAction("nmi")
+	assert.NotNil(t, err)
+
+	_, _, err = SetPowerStateFromInterfaces(context.Background(), time.Second, "nmi", []interface{}{&powerTester{}})
+	assert.EqualError(t, err, `unknown power action: "nmi"`)
+}
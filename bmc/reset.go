@@ -39,9 +39,15 @@ func resetBMC(ctx context.Context, timeout time.Duration, resetType string, b []
 			metadataLocal.ProvidersAttempted = append(metadataLocal.ProvidersAttempted, elem.name)
 			ctx, cancel := context.WithTimeout(ctx, timeout)
 			defer cancel()
-			ok, setErr := elem.bmcResetter.BmcReset(ctx, resetType)
+			start := time.Now()
+			var ok bool
+			setErr := metadataLocal.retry(ctx, elem.name, "BmcReset", false, func() (err error) {
+				ok, err = elem.bmcResetter.BmcReset(ctx, resetType)
+				return err
+			})
+			metadataLocal.setProviderDuration(elem.name, time.Since(start))
 			if setErr != nil {
-				err = multierror.Append(err, errors.WithMessagef(setErr, "provider: %v", elem.name))
+				err = multierror.Append(err, metadataLocal.providerFailed(elem.name, "BmcReset", setErr))
 				continue
 			}
 			if !ok {
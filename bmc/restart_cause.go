@@ -0,0 +1,109 @@
+package bmc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
+)
+
+// RestartCause is the reason the machine last restarted or powered on.
+type RestartCause string
+
+const (
+	RestartCauseUnknown RestartCause = "unknown"
+	// RestartCauseRemoteCommand is a power control command sent to the BMC, like a Redfish reset or an IPMI chassis power command.
+	RestartCauseRemoteCommand RestartCause = "remote-command"
+	RestartCausePowerButton   RestartCause = "power-button"
+	RestartCauseResetButton   RestartCause = "reset-button"
+	RestartCauseWatchdog      RestartCause = "watchdog"
+	// RestartCausePowerRestore is a power on after an AC power loss, as set by the power restore policy.
+	RestartCausePowerRestore RestartCause = "power-restore"
+	// RestartCausePEF is a reset or power cycle by an IPMI Platform Event Filter action.
+	RestartCausePEF RestartCause = "pef"
+	// RestartCauseSoftReset is a reset requested by the operating system, like a Ctrl-Alt-Del.
+	RestartCauseSoftReset RestartCause = "soft-reset"
+	RestartCauseRTCWakeup RestartCause = "rtc-wakeup"
+	RestartCauseOEM       RestartCause = "oem"
+)
+
+// RestartInfo describes the last restart of a machine.
+type RestartInfo struct {
+	Cause RestartCause
+	// Detail is the restart cause as reported by the BMC.
+	Detail string
+	// Time is when the machine last came out of reset, zero when not reported.
+	Time time.Time
+	// PowerOnHours is the cumulative power on time of the machine, zero when not reported.
+	PowerOnHours int
+}
+
+// RestartCauseGetter returns the cause and time of the last restart of a machine
+type RestartCauseGetter interface {
+	LastRestart(ctx context.Context) (info RestartInfo, err error)
+}
+
+type restartCauseGetterProvider struct {
+	name string
+	RestartCauseGetter
+}
+
+func lastRestart(ctx context.Context, timeout time.Duration, p []restartCauseGetterProvider) (info RestartInfo, metadata Metadata, err error) {
+	var metadataLocal Metadata
+
+	for _, elem := range p {
+		if elem.RestartCauseGetter == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return info, metadata, err
+		default:
+			metadataLocal.ProvidersAttempted = append(metadataLocal.ProvidersAttempted, elem.name)
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			start := time.Now()
+			var info RestartInfo
+			getErr := metadataLocal.retry(ctx, elem.name, "LastRestart", true, func() (err error) {
+				info, err = elem.LastRestart(ctx)
+				return err
+			})
+			metadataLocal.setProviderDuration(elem.name, time.Since(start))
+			if getErr != nil {
+				err = multierror.Append(err, metadataLocal.providerFailed(elem.name, "LastRestart", getErr))
+				continue
+			}
+
+			metadataLocal.SuccessfulProvider = elem.name
+			return info, metadataLocal, nil
+		}
+	}
+
+	return info, metadataLocal, multierror.Append(err, errors.New("failed to get last restart cause"))
+}
+
+// LastRestartFromInterfaces identifies implementations of the RestartCauseGetter interface and passes them to the lastRestart() wrapper method.
+func LastRestartFromInterfaces(ctx context.Context, timeout time.Duration, generic []interface{}) (info RestartInfo, metadata Metadata, err error) {
+	getters := make([]restartCauseGetterProvider, 0)
+	for _, elem := range generic {
+		temp := restartCauseGetterProvider{name: getProviderName(elem)}
+		switch p := elem.(type) {
+		case RestartCauseGetter:
+			temp.RestartCauseGetter = p
+			getters = append(getters, temp)
+		default:
+			e := fmt.Sprintf("not a RestartCauseGetter implementation: %T", p)
+			err = multierror.Append(err, errors.New(e))
+		}
+	}
+	if len(getters) == 0 {
+		return info, metadata, multierror.Append(err, errors.New("no RestartCauseGetter implementations found"))
+	}
+
+	return lastRestart(ctx, timeout, getters)
+}
@@ -0,0 +1,69 @@
+package bmc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type restartCauseTester struct {
+	info RestartInfo
+	err  error
+}
+
+func (r *restartCauseTester) LastRestart(ctx context.Context) (RestartInfo, error) {
+	return r.info, r.err
+}
+
+func (r *restartCauseTester) Name() string {
+	return "test provider"
+}
+
+func TestLastRestartFromInterfaces(t *testing.T) {
+	info := RestartInfo{Cause: RestartCauseWatchdog, Detail: "watchdog expired", PowerOnHours: 1234}
+
+	testCases := []struct {
+		name      string
+		providers []interface{}
+		want      RestartInfo
+		errMsg    string
+	}{
+		{
+			name:      "success",
+			providers: []interface{}{&restartCauseTester{info: info}},
+			want:      info,
+		},
+		{
+			name:      "first provider fails",
+			providers: []interface{}{&restartCauseTester{err: errors.New("no reset log entries")}, &restartCauseTester{info: info}},
+			want:      info,
+		},
+		{
+			name:      "all providers fail",
+			providers: []interface{}{&restartCauseTester{err: errors.New("no reset log entries")}},
+			errMsg:    "failed to get last restart cause",
+		},
+		{
+			name:      "no implementations",
+			providers: []interface{}{"foo"},
+			errMsg:    "no RestartCauseGetter implementations found",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, metadata, err := LastRestartFromInterfaces(context.Background(), time.Second, tc.providers)
+			if tc.errMsg != "" {
+				assert.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, "test provider", metadata.SuccessfulProvider)
+		})
+	}
+}
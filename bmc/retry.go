@@ -0,0 +1,187 @@
+package bmc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"time"
+
+	bmclibErrs "github.com/metal-toolbox/bmclib/errors"
+)
+
+const (
+	defaultRetryInitialBackoff = 500 * time.Millisecond
+	defaultRetryMultiplier     = 2
+)
+
+// RetryPolicy defines how a provider method call is retried when it fails with an error
+// classified as transient or BMC busy, other errors are not retried.
+//
+// Retries are made against the same provider before moving on to the next provider,
+// all attempts are bound by the per provider timeout.
+//
+// Methods that stream a payload to the BMC (FirmwareInstall, FirmwareUpload,
+// FirmwareInstallUploadAndInitiate and MountFloppyImage) are not retried by RetryNonIdempotent,
+// they are retried only when the policy has an entry in Overrides for the method with
+// RetryNonIdempotent set and the payload implements io.Seeker,
+// the payload is rewound to its initial offset before each retry.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts per provider, values less than 2 disable retries.
+	MaxAttempts int
+	// InitialBackoff is the wait duration before the first retry, defaults to 500ms.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the wait duration between retries, no cap is applied when zero.
+	MaxBackoff time.Duration
+	// Multiplier is applied to the backoff after each retry, defaults to 2.
+	Multiplier float64
+	// Jitter randomizes each backoff by up to the given fraction (0 - 1) of its duration.
+	Jitter float64
+	// RetryNonIdempotent enables retries for methods that are not idempotent,
+	// like power cycle, BMC reset and user create.
+	RetryNonIdempotent bool
+	// Overrides holds policies for specific provider methods, keyed by the method name,
+	// for example "PowerStateGet" or "Inventory".
+	Overrides map[string]RetryPolicy
+}
+
+type retryPolicyCtxKey struct{}
+
+// WithRetryPolicy returns a context that carries the given retry policy.
+func WithRetryPolicy(ctx context.Context, policy RetryPolicy) context.Context {
+	return context.WithValue(ctx, retryPolicyCtxKey{}, policy)
+}
+
+// RetryPolicyFromContext returns the retry policy carried by the context,
+// and a bool indicating if the context carried a policy.
+func RetryPolicyFromContext(ctx context.Context) (RetryPolicy, bool) {
+	policy, ok := ctx.Value(retryPolicyCtxKey{}).(RetryPolicy)
+	return policy, ok
+}
+
+// forOperation returns the policy for the given provider method.
+func (r RetryPolicy) forOperation(operation string) RetryPolicy {
+	if override, ok := r.Overrides[operation]; ok {
+		return override
+	}
+
+	return r
+}
+
+// backoff returns the wait duration before the given retry, retries are counted from 1.
+func (r RetryPolicy) backoff(retry int) time.Duration {
+	initial := r.InitialBackoff
+	if initial <= 0 {
+		initial = defaultRetryInitialBackoff
+	}
+
+	multiplier := r.Multiplier
+	if multiplier < 1 {
+		multiplier = defaultRetryMultiplier
+	}
+
+	backoff := float64(initial) * math.Pow(multiplier, float64(retry-1))
+	if r.MaxBackoff > 0 && backoff > float64(r.MaxBackoff) {
+		backoff = float64(r.MaxBackoff)
+	}
+
+	if r.Jitter > 0 {
+		jitter := math.Min(r.Jitter, 1)
+		// #nosec G404 -- jitter does not require a cryptographically secure random number
+		backoff += backoff * jitter * (rand.Float64()*2 - 1)
+	}
+
+	return time.Duration(backoff)
+}
+
+// retry calls fn as per the RetryPolicy carried by the context until it succeeds,
+// returns an error that is not retryable or the attempts are exhausted.
+//
+// idempotent indicates if the operation can be retried without RetryPolicy.RetryNonIdempotent set.
+// The number of attempts made and the last error returned by fn are returned.
+func retry(ctx context.Context, operation string, idempotent bool, fn func() error) (attempts int, err error) {
+	policy, ok := RetryPolicyFromContext(ctx)
+	if ok {
+		policy = policy.forOperation(operation)
+	}
+
+	for {
+		attempts++
+
+		err = fn()
+		if err == nil {
+			return attempts, nil
+		}
+
+		if !ok || attempts >= policy.MaxAttempts {
+			return attempts, err
+		}
+
+		if !idempotent && !policy.RetryNonIdempotent {
+			return attempts, err
+		}
+
+		if class := bmclibErrs.Classify(err); class != bmclibErrs.ErrorClassTransient && class != bmclibErrs.ErrorClassBusy {
+			return attempts, err
+		}
+
+		// the context deadline exceeded error is classified as transient,
+		// there is no point in retrying once the context is done.
+		timer := time.NewTimer(policy.backoff(attempts))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return attempts, err
+		case <-timer.C:
+		}
+	}
+}
+
+// retry calls the provider method as per the RetryPolicy carried by the context,
+// the number of attempts is recorded when the method was retried.
+func (m *Metadata) retry(ctx context.Context, provider, operation string, idempotent bool, fn func() error) error {
+	attempts, err := retry(ctx, operation, idempotent, fn)
+	if attempts > 1 {
+		m.setProviderAttempts(provider, attempts)
+	}
+
+	return err
+}
+
+// retryPayload calls the provider method that streams the payload as per the RetryPolicy carried by the context,
+// the method is retried only when the policy has an override for the operation and the payload can be rewound.
+func (m *Metadata) retryPayload(ctx context.Context, provider, operation string, payload io.Reader, fn func() error) error {
+	policy, ok := RetryPolicyFromContext(ctx)
+	if !ok {
+		return fn()
+	}
+
+	if _, ok := policy.Overrides[operation]; !ok {
+		return fn()
+	}
+
+	seeker, ok := payload.(io.Seeker)
+	if !ok {
+		return fn()
+	}
+
+	offset, err := seeker.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return fn()
+	}
+
+	var attempted bool
+
+	return m.retry(ctx, provider, operation, false, func() error {
+		if attempted {
+			if _, err := seeker.Seek(offset, io.SeekStart); err != nil {
+				return fmt.Errorf("rewinding payload: %w", err)
+			}
+		}
+
+		attempted = true
+
+		return fn()
+	})
+}
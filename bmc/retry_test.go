@@ -0,0 +1,253 @@
+package bmc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	bmclibErrs "github.com/metal-toolbox/bmclib/errors"
+)
+
+type flakyPowerProvider struct {
+	name     string
+	failures int
+	err      error
+	calls    int
+}
+
+func (f *flakyPowerProvider) PowerStateGet(ctx context.Context) (string, error) {
+	f.calls++
+	if f.calls <= f.failures {
+		return "", f.err
+	}
+
+	return "on", nil
+}
+
+func (f *flakyPowerProvider) PowerSet(ctx context.Context, state string) (bool, error) {
+	f.calls++
+	if f.calls <= f.failures {
+		return false, f.err
+	}
+
+	return true, nil
+}
+
+func (f *flakyPowerProvider) Name() string {
+	return f.name
+}
+
+func TestRetryPolicy(t *testing.T) {
+	transientErr := bmclibErrs.NewHTTPError(503, errors.New("service unavailable"))
+
+	testCases := []struct {
+		name         string
+		policy       *RetryPolicy
+		failures     int
+		err          error
+		set          string
+		wantCalls    int
+		wantAttempts int
+		wantErr      bool
+	}{
+		{
+			name:      "no policy",
+			failures:  1,
+			err:       transientErr,
+			wantCalls: 1,
+			wantErr:   true,
+		},
+		{
+			name:         "transient error is retried",
+			policy:       &RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond},
+			failures:     2,
+			err:          transientErr,
+			wantCalls:    3,
+			wantAttempts: 3,
+		},
+		{
+			name:         "attempts exhausted",
+			policy:       &RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond},
+			failures:     5,
+			err:          transientErr,
+			wantCalls:    2,
+			wantAttempts: 2,
+			wantErr:      true,
+		},
+		{
+			name:      "permanent error is not retried",
+			policy:    &RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond},
+			failures:  1,
+			err:       bmclibErrs.NewHTTPError(400, errors.New("bad request")),
+			wantCalls: 1,
+			wantErr:   true,
+		},
+		{
+			name: "operation override",
+			policy: &RetryPolicy{
+				MaxAttempts:    3,
+				InitialBackoff: time.Millisecond,
+				Overrides:      map[string]RetryPolicy{"PowerStateGet": {MaxAttempts: 1}},
+			},
+			failures:  1,
+			err:       transientErr,
+			wantCalls: 1,
+			wantErr:   true,
+		},
+		{
+			name:      "non idempotent power set is not retried",
+			policy:    &RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond},
+			failures:  1,
+			err:       transientErr,
+			set:       "cycle",
+			wantCalls: 1,
+			wantErr:   true,
+		},
+		{
+			name:         "non idempotent power set retried when allowed",
+			policy:       &RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, RetryNonIdempotent: true},
+			failures:     1,
+			err:          transientErr,
+			set:          "cycle",
+			wantCalls:    2,
+			wantAttempts: 2,
+		},
+		{
+			name:         "idempotent power set is retried",
+			policy:       &RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond},
+			failures:     1,
+			err:          transientErr,
+			set:          "on",
+			wantCalls:    2,
+			wantAttempts: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			provider := &flakyPowerProvider{name: "flaky", failures: tc.failures, err: tc.err}
+
+			ctx := context.Background()
+			if tc.policy != nil {
+				ctx = WithRetryPolicy(ctx, *tc.policy)
+			}
+
+			var metadata Metadata
+			var err error
+			if tc.set != "" {
+				_, metadata, err = SetPowerStateFromInterfaces(ctx, 5*time.Second, tc.set, []interface{}{provider})
+			} else {
+				_, metadata, err = GetPowerStateFromInterfaces(ctx, 5*time.Second, []interface{}{provider})
+			}
+
+			if tc.wantErr {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+
+			assert.Equal(t, tc.wantCalls, provider.calls)
+			assert.Equal(t, tc.wantAttempts, metadata.ProviderAttempts["flaky"])
+		})
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: 300 * time.Millisecond}
+
+	assert.Equal(t, 100*time.Millisecond, policy.backoff(1))
+	assert.Equal(t, 200*time.Millisecond, policy.backoff(2))
+	assert.Equal(t, 300*time.Millisecond, policy.backoff(3))
+
+	policy.Jitter = 0.5
+	for i := 0; i < 10; i++ {
+		backoff := policy.backoff(1)
+		assert.GreaterOrEqual(t, backoff, 50*time.Millisecond)
+		assert.LessOrEqual(t, backoff, 150*time.Millisecond)
+	}
+}
+
+type flakyFloppyProvider struct {
+	failures int
+	calls    int
+	reads    []string
+}
+
+func (f *flakyFloppyProvider) MountFloppyImage(ctx context.Context, image io.Reader) error {
+	f.calls++
+
+	b, err := io.ReadAll(image)
+	if err != nil {
+		return err
+	}
+
+	f.reads = append(f.reads, string(b))
+
+	if f.calls <= f.failures {
+		return bmclibErrs.NewHTTPError(503, errors.New("service unavailable"))
+	}
+
+	return nil
+}
+
+func (f *flakyFloppyProvider) UnmountFloppyImage(ctx context.Context) error {
+	return nil
+}
+
+func (f *flakyFloppyProvider) Name() string {
+	return "flaky"
+}
+
+func TestRetryPolicyPayload(t *testing.T) {
+	override := map[string]RetryPolicy{"MountFloppyImage": {MaxAttempts: 3, InitialBackoff: time.Millisecond, RetryNonIdempotent: true}}
+
+	testCases := []struct {
+		name      string
+		policy    RetryPolicy
+		image     func() io.Reader
+		wantReads []string
+		wantErr   bool
+	}{
+		{
+			name:      "not retried with retry non idempotent",
+			policy:    RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, RetryNonIdempotent: true},
+			image:     func() io.Reader { return strings.NewReader("image") },
+			wantReads: []string{"image"},
+			wantErr:   true,
+		},
+		{
+			name:      "retried with an override and a seekable payload",
+			policy:    RetryPolicy{Overrides: override},
+			image:     func() io.Reader { return strings.NewReader("image") },
+			wantReads: []string{"image", "image"},
+		},
+		{
+			name:      "not retried with an override and a payload that can't be rewound",
+			policy:    RetryPolicy{Overrides: override},
+			image:     func() io.Reader { return io.MultiReader(strings.NewReader("image")) },
+			wantReads: []string{"image"},
+			wantErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			provider := &flakyFloppyProvider{failures: 1}
+
+			ctx := WithRetryPolicy(context.Background(), tc.policy)
+			_, err := MountFloppyImageFromInterfaces(ctx, tc.image(), []interface{}{provider})
+			if tc.wantErr {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+
+			assert.Equal(t, tc.wantReads, provider.reads)
+		})
+	}
+}
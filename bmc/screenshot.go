@@ -3,6 +3,7 @@ package bmc
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	bmclibErrs "github.com/metal-toolbox/bmclib/errors"
@@ -34,9 +35,16 @@ func screenshot(ctx context.Context, generic []screenshotGetterProvider) (image
 			return image, fileType, metadata, err
 		default:
 			metadataLocal.ProvidersAttempted = append(metadataLocal.ProvidersAttempted, elem.name)
-			image, fileType, vErr := elem.Screenshot(ctx)
+			var image []byte
+			start := time.Now()
+			var fileType string
+			vErr := metadataLocal.retry(ctx, elem.name, "Screenshot", true, func() (err error) {
+				image, fileType, err = elem.Screenshot(ctx)
+				return err
+			})
+			metadataLocal.setProviderDuration(elem.name, time.Since(start))
 			if vErr != nil {
-				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				err = multierror.Append(err, metadataLocal.providerFailed(elem.name, "Screenshot", vErr))
 				continue
 
 			}
@@ -2,7 +2,9 @@ package bmc
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/hashicorp/go-multierror"
@@ -23,6 +25,195 @@ type systemEventLogProviders struct {
 
 type SystemEventLogEntries [][]string
 
+// SystemEventLogSeverity is the severity of a System Event Log entry.
+type SystemEventLogSeverity string
+
+const (
+	SystemEventLogSeverityInfo     SystemEventLogSeverity = "info"
+	SystemEventLogSeverityWarning  SystemEventLogSeverity = "warning"
+	SystemEventLogSeverityCritical SystemEventLogSeverity = "critical"
+	SystemEventLogSeverityUnknown  SystemEventLogSeverity = "unknown"
+)
+
+// SystemEventDirection is whether the event condition was asserted or deasserted.
+type SystemEventDirection string
+
+const (
+	SystemEventAsserted   SystemEventDirection = "asserted"
+	SystemEventDeasserted SystemEventDirection = "deasserted"
+)
+
+// SystemEventLogEntry is a System Event Log entry.
+type SystemEventLogEntry struct {
+	ID string
+	// Timestamp is zero for entries logged before the BMC clock was set, like the ipmitool Pre-Init entries.
+	Timestamp    time.Time
+	Severity     SystemEventLogSeverity
+	SensorType   string
+	SensorNumber string
+	// Direction is empty when the provider doesn't report it.
+	Direction SystemEventDirection
+	Message   string
+	// LogService is the log the entry was read from for providers reading several logs,
+	// the Redfish LogService @odata.id, it is empty for providers reading a single log.
+	LogService string
+	// Raw is the entry as returned by the BMC, the sel list line for ipmitool and the LogEntry JSON for Redfish.
+	Raw string
+}
+
+// SystemEventLogCursor is the position of the last System Event Log entry read.
+type SystemEventLogCursor struct {
+	// ID is the ID of the last entry read.
+	ID string
+	// Timestamp is the time of the last entry read, used when the ID is not found in the log
+	// and to tell apart entries with the same ID once the log was cleared.
+	Timestamp time.Time
+	// LogServices are the positions of the last entry read from each log, keyed by the entry LogService,
+	// since the IDs of the entries are only unique within their log.
+	LogServices map[string]SystemEventLogCursor
+}
+
+// IsZero returns true for the cursor before the first entry.
+func (c SystemEventLogCursor) IsZero() bool {
+	return c.ID == "" && c.Timestamp.IsZero() && len(c.LogServices) == 0
+}
+
+// Advance returns the cursor positioned after the entry.
+func (c SystemEventLogCursor) Advance(entry SystemEventLogEntry) SystemEventLogCursor {
+	next := SystemEventLogCursor{ID: entry.ID, Timestamp: entry.Timestamp}
+	if len(c.LogServices) == 0 && entry.LogService == "" {
+		return next
+	}
+
+	next.LogServices = make(map[string]SystemEventLogCursor, len(c.LogServices)+1)
+	for service, position := range c.LogServices {
+		next.LogServices[service] = position
+	}
+
+	if entry.LogService != "" {
+		next.LogServices[entry.LogService] = SystemEventLogCursor{ID: entry.ID, Timestamp: entry.Timestamp}
+	}
+
+	return next
+}
+
+// logServiceCursor returns the position in the log, the entries of a log the cursor has no position for
+// are those with a Timestamp after the last entry read.
+func (c SystemEventLogCursor) logServiceCursor(service string) SystemEventLogCursor {
+	if service == "" {
+		return SystemEventLogCursor{ID: c.ID, Timestamp: c.Timestamp}
+	}
+
+	if position, ok := c.LogServices[service]; ok {
+		return position
+	}
+
+	return SystemEventLogCursor{Timestamp: c.Timestamp}
+}
+
+// SystemEventLogEntriesSince returns the entries logged after the cursor along with the cursor of the last entry.
+//
+// The entries of each log are ordered by their Timestamp, so that logs listing the newest entries first are
+// read in the order the entries were logged, the entries without a Timestamp keep their place after the entry
+// preceding them. The entries after the one matching the log cursor ID and Timestamp are returned, when there
+// is no such entry, like when the log was cleared, the entries with a Timestamp after the cursor Timestamp are
+// returned, or all of the entries when the cursor has no Timestamp. The entries of all the logs are returned
+// ordered by their Timestamp, and the cursor is returned unchanged when there are no new entries.
+func SystemEventLogEntriesSince(entries []SystemEventLogEntry, cursor SystemEventLogCursor) ([]SystemEventLogEntry, SystemEventLogCursor) {
+	var services []string
+	byService := map[string][]SystemEventLogEntry{}
+	for _, e := range entries {
+		if _, ok := byService[e.LogService]; !ok {
+			services = append(services, e.LogService)
+		}
+
+		byService[e.LogService] = append(byService[e.LogService], e)
+	}
+
+	since := []SystemEventLogEntry{}
+	for _, service := range services {
+		since = append(since, logEntriesSince(sortByTimestamp(byService[service]), cursor.logServiceCursor(service))...)
+	}
+
+	since = sortByTimestamp(since)
+
+	next := cursor
+	for _, e := range since {
+		next = next.Advance(e)
+	}
+
+	return since, next
+}
+
+// logEntriesSince returns the entries of a log logged after the cursor
+func logEntriesSince(entries []SystemEventLogEntry, cursor SystemEventLogCursor) []SystemEventLogEntry {
+	if cursor.IsZero() {
+		return entries
+	}
+
+	if cursor.ID != "" {
+		for i := len(entries) - 1; i >= 0; i-- {
+			if entries[i].ID == cursor.ID && (cursor.Timestamp.IsZero() || entries[i].Timestamp.Equal(cursor.Timestamp)) {
+				return entries[i+1:]
+			}
+		}
+	}
+
+	if cursor.Timestamp.IsZero() {
+		return entries
+	}
+
+	since := []SystemEventLogEntry{}
+	for _, e := range entries {
+		if e.Timestamp.After(cursor.Timestamp) {
+			since = append(since, e)
+		}
+	}
+
+	return since
+}
+
+// sortByTimestamp returns the entries ordered by their Timestamp, the entries without a Timestamp are
+// ordered by the Timestamp of the entry preceding them in their log.
+func sortByTimestamp(entries []SystemEventLogEntry) []SystemEventLogEntry {
+	type sortable struct {
+		key time.Time
+		SystemEventLogEntry
+	}
+
+	sorted := make([]sortable, 0, len(entries))
+
+	keys := map[string]time.Time{}
+	for _, e := range entries {
+		if !e.Timestamp.IsZero() {
+			keys[e.LogService] = e.Timestamp
+		}
+
+		sorted = append(sorted, sortable{key: keys[e.LogService], SystemEventLogEntry: e})
+	}
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].key.Before(sorted[j].key)
+	})
+
+	result := make([]SystemEventLogEntry, 0, len(sorted))
+	for _, e := range sorted {
+		result = append(result, e.SystemEventLogEntry)
+	}
+
+	return result
+}
+
+// SystemEventLogEntriesGetter returns the typed System Event Log entries
+type SystemEventLogEntriesGetter interface {
+	GetSystemEventLogEntries(ctx context.Context) (entries []SystemEventLogEntry, err error)
+}
+
+type systemEventLogEntriesGetterProvider struct {
+	name string
+	SystemEventLogEntriesGetter
+}
+
 func clearSystemEventLog(ctx context.Context, timeout time.Duration, s []systemEventLogProviders) (metadata Metadata, err error) {
 	var metadataLocal Metadata
 
@@ -39,9 +230,13 @@ func clearSystemEventLog(ctx context.Context, timeout time.Duration, s []systemE
 			metadataLocal.ProvidersAttempted = append(metadataLocal.ProvidersAttempted, elem.name)
 			ctx, cancel := context.WithTimeout(ctx, timeout)
 			defer cancel()
-			selErr := elem.systemEventLogProvider.ClearSystemEventLog(ctx)
+			start := time.Now()
+			selErr := metadataLocal.retry(ctx, elem.name, "ClearSystemEventLog", true, func() error {
+				return elem.systemEventLogProvider.ClearSystemEventLog(ctx)
+			})
+			metadataLocal.setProviderDuration(elem.name, time.Since(start))
 			if selErr != nil {
-				err = multierror.Append(err, errors.WithMessagef(selErr, "provider: %v", elem.name))
+				err = multierror.Append(err, metadataLocal.providerFailed(elem.name, "ClearSystemEventLog", selErr))
 				continue
 			}
 			metadataLocal.SuccessfulProvider = elem.name
@@ -89,9 +284,15 @@ func getSystemEventLog(ctx context.Context, timeout time.Duration, s []systemEve
 			ctx, cancel := context.WithTimeout(ctx, timeout)
 			defer cancel()
 
-			sel, selErr := elem.systemEventLogProvider.GetSystemEventLog(ctx)
+			start := time.Now()
+			var sel [][]string
+			selErr := metadataLocal.retry(ctx, elem.name, "GetSystemEventLog", true, func() (err error) {
+				sel, err = elem.systemEventLogProvider.GetSystemEventLog(ctx)
+				return err
+			})
+			metadataLocal.setProviderDuration(elem.name, time.Since(start))
 			if selErr != nil {
-				err = multierror.Append(err, errors.WithMessagef(selErr, "provider: %v", elem.name))
+				err = multierror.Append(err, metadataLocal.providerFailed(elem.name, "GetSystemEventLog", selErr))
 				continue
 			}
 
@@ -120,6 +321,22 @@ func GetSystemEventLogFromInterfaces(ctx context.Context, timeout time.Duration,
 	if len(selServices) == 0 {
 		return sel, metadata, multierror.Append(err, errors.New("no SystemEventLog implementations found"))
 	}
+
+	if strategy, _ := ExecutionStrategyFromContext(ctx); strategy.concurrent() {
+		// the column formats differ between the providers, so their results are not compared
+		if strategy == ExecutionConsensus {
+			strategy = ExecutionCollectAll
+		}
+
+		calls := make([]providerCall[[][]string], 0, len(selServices))
+		for _, elem := range selServices {
+			calls = append(calls, providerCall[[][]string]{name: elem.name, call: elem.systemEventLogProvider.GetSystemEventLog})
+		}
+
+		entries, metadata, err := runConcurrent(ctx, timeout, strategy, "GetSystemEventLog", calls, nil, "failed to get System Event Log")
+		return entries, metadata, err
+	}
+
 	return getSystemEventLog(ctx, timeout, selServices)
 }
 
@@ -140,9 +357,15 @@ func getSystemEventLogRaw(ctx context.Context, timeout time.Duration, s []system
 			ctx, cancel := context.WithTimeout(ctx, timeout)
 			defer cancel()
 
-			eventlog, selErr := elem.systemEventLogProvider.GetSystemEventLogRaw(ctx)
+			start := time.Now()
+			var eventlog string
+			selErr := metadataLocal.retry(ctx, elem.name, "GetSystemEventLogRaw", true, func() (err error) {
+				eventlog, err = elem.systemEventLogProvider.GetSystemEventLogRaw(ctx)
+				return err
+			})
+			metadataLocal.setProviderDuration(elem.name, time.Since(start))
 			if selErr != nil {
-				err = multierror.Append(err, errors.WithMessagef(selErr, "provider: %v", elem.name))
+				err = multierror.Append(err, metadataLocal.providerFailed(elem.name, "GetSystemEventLogRaw", selErr))
 				continue
 			}
 
@@ -173,3 +396,81 @@ func GetSystemEventLogRawFromInterfaces(ctx context.Context, timeout time.Durati
 	}
 	return getSystemEventLogRaw(ctx, timeout, selServices)
 }
+
+func getSystemEventLogEntries(ctx context.Context, timeout time.Duration, p []systemEventLogEntriesGetterProvider) (entries []SystemEventLogEntry, metadata Metadata, err error) {
+	var metadataLocal Metadata
+
+	for _, elem := range p {
+		if elem.SystemEventLogEntriesGetter == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return entries, metadata, err
+		default:
+			metadataLocal.ProvidersAttempted = append(metadataLocal.ProvidersAttempted, elem.name)
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			start := time.Now()
+			var entries []SystemEventLogEntry
+			selErr := metadataLocal.retry(ctx, elem.name, "GetSystemEventLogEntries", true, func() (err error) {
+				entries, err = elem.GetSystemEventLogEntries(ctx)
+				return err
+			})
+			metadataLocal.setProviderDuration(elem.name, time.Since(start))
+			if selErr != nil {
+				err = multierror.Append(err, metadataLocal.providerFailed(elem.name, "GetSystemEventLogEntries", selErr))
+				continue
+			}
+
+			metadataLocal.SuccessfulProvider = elem.name
+			return entries, metadataLocal, nil
+		}
+	}
+
+	return nil, metadataLocal, multierror.Append(err, errors.New("failed to get System Event Log entries"))
+}
+
+// GetSystemEventLogEntriesFromInterfaces identifies implementations of the SystemEventLogEntriesGetter interface and passes them to the getSystemEventLogEntries() wrapper method.
+func GetSystemEventLogEntriesFromInterfaces(ctx context.Context, timeout time.Duration, generic []interface{}) (entries []SystemEventLogEntry, metadata Metadata, err error) {
+	getters := make([]systemEventLogEntriesGetterProvider, 0)
+	for _, elem := range generic {
+		temp := systemEventLogEntriesGetterProvider{name: getProviderName(elem)}
+		switch p := elem.(type) {
+		case SystemEventLogEntriesGetter:
+			temp.SystemEventLogEntriesGetter = p
+			getters = append(getters, temp)
+		default:
+			e := fmt.Sprintf("not a SystemEventLogEntriesGetter implementation: %T", p)
+			err = multierror.Append(err, errors.New(e))
+		}
+	}
+	if len(getters) == 0 {
+		return entries, metadata, multierror.Append(err, errors.New("no SystemEventLogEntriesGetter implementations found"))
+	}
+
+	if strategy, _ := ExecutionStrategyFromContext(ctx); strategy.concurrent() {
+		calls := make([]providerCall[[]SystemEventLogEntry], 0, len(getters))
+		for _, elem := range getters {
+			calls = append(calls, providerCall[[]SystemEventLogEntry]{name: elem.name, call: elem.GetSystemEventLogEntries})
+		}
+
+		return runConcurrent(ctx, timeout, strategy, "GetSystemEventLogEntries", calls, normalizeSystemEventLogEntries, "failed to get System Event Log entries")
+	}
+
+	return getSystemEventLogEntries(ctx, timeout, getters)
+}
+
+// normalizeSystemEventLogEntries returns a comparable summary of the entries, the number of entries and a digest
+// of their ID, Timestamp and Message, leaving out the provider specific Raw and LogService fields.
+func normalizeSystemEventLogEntries(entries []SystemEventLogEntry) string {
+	h := sha256.New()
+	for _, e := range entries {
+		fmt.Fprintf(h, "%s\x00%s\x00%s\n", e.ID, e.Timestamp.UTC().Format(time.RFC3339), e.Message)
+	}
+
+	return fmt.Sprintf("entries=%d sha256=%x", len(entries), h.Sum(nil)[:8])
+}
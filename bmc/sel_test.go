@@ -10,8 +10,9 @@ import (
 )
 
 type mockSystemEventLogService struct {
-	name string
-	err  error
+	name    string
+	entries []SystemEventLogEntry
+	err     error
 }
 
 func (m *mockSystemEventLogService) ClearSystemEventLog(ctx context.Context) error {
@@ -26,6 +27,10 @@ func (m *mockSystemEventLogService) GetSystemEventLogRaw(ctx context.Context) (e
 	return "", m.err
 }
 
+func (m *mockSystemEventLogService) GetSystemEventLogEntries(ctx context.Context) (entries []SystemEventLogEntry, err error) {
+	return m.entries, m.err
+}
+
 func (m *mockSystemEventLogService) Name() string {
 	return m.name
 }
@@ -133,3 +138,162 @@ func TestGetSystemEventLogRawFromInterfaces(t *testing.T) {
 	_, _, err = GetSystemEventLogRawFromInterfaces(ctx, timeout, []interface{}{mockService})
 	assert.Nil(t, err)
 }
+
+func TestGetSystemEventLogEntriesFromInterfaces(t *testing.T) {
+	ctx := context.Background()
+	timeout := 1 * time.Second
+
+	// Test with an empty slice
+	_, _, err := GetSystemEventLogEntriesFromInterfaces(ctx, timeout, []interface{}{})
+	assert.NotNil(t, err)
+
+	// Test with a slice containing a non-SystemEventLogEntriesGetter object
+	_, _, err = GetSystemEventLogEntriesFromInterfaces(ctx, timeout, []interface{}{"not a SystemEventLog Service"})
+	assert.NotNil(t, err)
+
+	// Test with a mock SystemEventLogService that returns an error, followed by one that returns nil
+	failing := &mockSystemEventLogService{name: "mock1", err: errors.New("mock error")}
+	mockService := &mockSystemEventLogService{name: "mock2"}
+	_, metadata, err := GetSystemEventLogEntriesFromInterfaces(ctx, timeout, []interface{}{failing, mockService})
+	assert.Nil(t, err)
+	assert.Equal(t, mockService.name, metadata.SuccessfulProvider)
+}
+
+func TestSystemEventLogEntriesSince(t *testing.T) {
+	at := func(minute int) time.Time {
+		return time.Date(2024, 3, 19, 10, minute, 0, 0, time.UTC)
+	}
+
+	entries := []SystemEventLogEntry{
+		{ID: "1", Timestamp: at(1)},
+		{ID: "2", Timestamp: at(2)},
+		{ID: "3", Timestamp: at(3)},
+	}
+
+	testCases := []struct {
+		name       string
+		cursor     SystemEventLogCursor
+		want       []SystemEventLogEntry
+		wantCursor SystemEventLogCursor
+	}{
+		{
+			name:       "zero cursor",
+			want:       entries,
+			wantCursor: SystemEventLogCursor{ID: "3", Timestamp: at(3)},
+		},
+		{
+			name:       "after id",
+			cursor:     SystemEventLogCursor{ID: "1"},
+			want:       entries[1:],
+			wantCursor: SystemEventLogCursor{ID: "3", Timestamp: at(3)},
+		},
+		{
+			name:       "after timestamp",
+			cursor:     SystemEventLogCursor{Timestamp: at(2)},
+			want:       entries[2:],
+			wantCursor: SystemEventLogCursor{ID: "3", Timestamp: at(3)},
+		},
+		{
+			name:       "no new entries",
+			cursor:     SystemEventLogCursor{ID: "3", Timestamp: at(3)},
+			want:       []SystemEventLogEntry{},
+			wantCursor: SystemEventLogCursor{ID: "3", Timestamp: at(3)},
+		},
+		{
+			name:       "id reused after the log was cleared",
+			cursor:     SystemEventLogCursor{ID: "2", Timestamp: at(0)},
+			want:       entries,
+			wantCursor: SystemEventLogCursor{ID: "3", Timestamp: at(3)},
+		},
+		{
+			name:       "id not found",
+			cursor:     SystemEventLogCursor{ID: "7"},
+			want:       entries,
+			wantCursor: SystemEventLogCursor{ID: "3", Timestamp: at(3)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, cursor := SystemEventLogEntriesSince(entries, tc.cursor)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.wantCursor, cursor)
+		})
+	}
+}
+
+func TestSystemEventLogEntriesSinceLogServices(t *testing.T) {
+	at := func(minute int) time.Time {
+		return time.Date(2024, 3, 19, 10, minute, 0, 0, time.UTC)
+	}
+
+	const sel, lclog = "/redfish/v1/Managers/1/LogServices/Sel", "/redfish/v1/Managers/1/LogServices/Lclog"
+
+	// the entry IDs are only unique within their log, the Lclog lists the newest entries first
+	s1 := SystemEventLogEntry{ID: "1", Timestamp: at(1), LogService: sel}
+	s2 := SystemEventLogEntry{ID: "2", Timestamp: at(3), LogService: sel}
+	s3 := SystemEventLogEntry{ID: "3", Timestamp: at(5), LogService: sel}
+	l1 := SystemEventLogEntry{ID: "1", Timestamp: at(2), LogService: lclog}
+	l2 := SystemEventLogEntry{ID: "2", Timestamp: at(4), LogService: lclog}
+	l3 := SystemEventLogEntry{ID: "3", Timestamp: at(6), LogService: lclog}
+
+	got, cursor := SystemEventLogEntriesSince([]SystemEventLogEntry{s1, s2, l2, l1}, SystemEventLogCursor{})
+	assert.Equal(t, []SystemEventLogEntry{s1, l1, s2, l2}, got)
+	assert.Equal(t, SystemEventLogCursor{
+		ID:        "2",
+		Timestamp: at(4),
+		LogServices: map[string]SystemEventLogCursor{
+			sel:   {ID: "2", Timestamp: at(3)},
+			lclog: {ID: "2", Timestamp: at(4)},
+		},
+	}, cursor)
+
+	got, cursor = SystemEventLogEntriesSince([]SystemEventLogEntry{s1, s2, s3, l3, l2, l1}, cursor)
+	assert.Equal(t, []SystemEventLogEntry{s3, l3}, got)
+	assert.Equal(t, SystemEventLogCursor{
+		ID:        "3",
+		Timestamp: at(6),
+		LogServices: map[string]SystemEventLogCursor{
+			sel:   {ID: "3", Timestamp: at(5)},
+			lclog: {ID: "3", Timestamp: at(6)},
+		},
+	}, cursor)
+
+	// the Lclog cursor ID matches a Sel entry that was not read yet
+	got, _ = SystemEventLogEntriesSince([]SystemEventLogEntry{s1, s2, l2, l1}, SystemEventLogCursor{
+		ID:        "2",
+		Timestamp: at(4),
+		LogServices: map[string]SystemEventLogCursor{
+			sel:   {ID: "1", Timestamp: at(1)},
+			lclog: {ID: "2", Timestamp: at(4)},
+		},
+	})
+	assert.Equal(t, []SystemEventLogEntry{s2}, got)
+}
+
+func TestConsensusSystemEventLogEntries(t *testing.T) {
+	ctx := WithExecutionStrategy(context.Background(), ExecutionConsensus)
+
+	entry := SystemEventLogEntry{ID: "1", Timestamp: time.Date(2024, 3, 19, 10, 11, 12, 0, time.UTC), Message: "Upper Critical going high"}
+	ipmitool, redfish := entry, entry
+	ipmitool.Raw = "1 | 03/19/2024 | 10:11:12 | Temperature #0x30 | Upper Critical going high | Asserted"
+	redfish.Raw = `{"Id": "1"}`
+	redfish.LogService = "/redfish/v1/Managers/1/LogServices/Sel"
+
+	// the provider specific fields are not compared
+	_, metadata, err := GetSystemEventLogEntriesFromInterfaces(ctx, time.Second, []interface{}{
+		&mockSystemEventLogService{name: "ipmitool", entries: []SystemEventLogEntry{ipmitool}},
+		&mockSystemEventLogService{name: "gofish", entries: []SystemEventLogEntry{redfish}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(metadata.ProviderResults))
+	assert.False(t, metadata.Conflict)
+
+	redfish.Message = "Lower Critical going low"
+	_, metadata, err = GetSystemEventLogEntriesFromInterfaces(ctx, time.Second, []interface{}{
+		&mockSystemEventLogService{name: "ipmitool", entries: []SystemEventLogEntry{ipmitool}},
+		&mockSystemEventLogService{name: "gofish", entries: []SystemEventLogEntry{redfish}},
+	})
+	assert.Nil(t, err)
+	assert.True(t, metadata.Conflict)
+}
@@ -0,0 +1,133 @@
+package bmc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
+)
+
+// SensorType is the kind of quantity a sensor measures.
+type SensorType string
+
+const (
+	SensorTypeTemperature SensorType = "temperature"
+	SensorTypeFan         SensorType = "fan"
+	SensorTypeVoltage     SensorType = "voltage"
+	SensorTypeCurrent     SensorType = "current"
+	SensorTypePower       SensorType = "power"
+	SensorTypeOther       SensorType = "other"
+)
+
+// The units of the sensor readings, sensors of other types keep the unit reported by the BMC.
+const (
+	SensorUnitCelsius = "C"
+	SensorUnitRPM     = "RPM"
+	SensorUnitPercent = "%"
+	SensorUnitVolts   = "V"
+	SensorUnitAmps    = "A"
+	SensorUnitWatts   = "W"
+)
+
+// SensorStatus is the health of a sensor reading.
+type SensorStatus string
+
+const (
+	SensorStatusOK       SensorStatus = "ok"
+	SensorStatusWarning  SensorStatus = "warning"
+	SensorStatusCritical SensorStatus = "critical"
+	// SensorStatusUnavailable is the status of sensors that are absent, disabled or have no reading.
+	SensorStatusUnavailable SensorStatus = "unavailable"
+	SensorStatusUnknown     SensorStatus = "unknown"
+)
+
+// SensorThresholds are the thresholds of a sensor reading, thresholds not reported by the BMC are nil.
+type SensorThresholds struct {
+	LowerNonCritical    *float64
+	LowerCritical       *float64
+	LowerNonRecoverable *float64
+	UpperNonCritical    *float64
+	UpperCritical       *float64
+	UpperNonRecoverable *float64
+}
+
+// SensorReading is the reading of a BMC sensor.
+type SensorReading struct {
+	Name string
+	Type SensorType
+	// Value is nil for sensors without a reading, like discrete or absent sensors.
+	Value      *float64
+	Unit       string
+	Thresholds SensorThresholds
+	Status     SensorStatus
+}
+
+// SensorReader returns the readings of the machine sensors like temperatures, fans and voltages
+type SensorReader interface {
+	SensorsRead(ctx context.Context) (readings []SensorReading, err error)
+}
+
+type sensorReaderProvider struct {
+	name string
+	SensorReader
+}
+
+func readSensors(ctx context.Context, timeout time.Duration, p []sensorReaderProvider) (readings []SensorReading, metadata Metadata, err error) {
+	var metadataLocal Metadata
+
+	for _, elem := range p {
+		if elem.SensorReader == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return readings, metadata, err
+		default:
+			metadataLocal.ProvidersAttempted = append(metadataLocal.ProvidersAttempted, elem.name)
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			start := time.Now()
+			var readings []SensorReading
+			readErr := metadataLocal.retry(ctx, elem.name, "SensorsRead", true, func() (err error) {
+				readings, err = elem.SensorsRead(ctx)
+				return err
+			})
+			metadataLocal.setProviderDuration(elem.name, time.Since(start))
+			if readErr != nil {
+				err = multierror.Append(err, metadataLocal.providerFailed(elem.name, "SensorsRead", readErr))
+				continue
+			}
+
+			metadataLocal.SuccessfulProvider = elem.name
+			return readings, metadataLocal, nil
+		}
+	}
+
+	return readings, metadataLocal, multierror.Append(err, errors.New("failed to read sensors"))
+}
+
+// ReadSensorsFromInterfaces identifies implementations of the SensorReader interface and passes them to the readSensors() wrapper method.
+func ReadSensorsFromInterfaces(ctx context.Context, timeout time.Duration, generic []interface{}) (readings []SensorReading, metadata Metadata, err error) {
+	readers := make([]sensorReaderProvider, 0)
+	for _, elem := range generic {
+		temp := sensorReaderProvider{name: getProviderName(elem)}
+		switch p := elem.(type) {
+		case SensorReader:
+			temp.SensorReader = p
+			readers = append(readers, temp)
+		default:
+			e := fmt.Sprintf("not a SensorReader implementation: %T", p)
+			err = multierror.Append(err, errors.New(e))
+		}
+	}
+	if len(readers) == 0 {
+		return readings, metadata, multierror.Append(err, errors.New("no SensorReader implementations found"))
+	}
+
+	return readSensors(ctx, timeout, readers)
+}
@@ -0,0 +1,79 @@
+package bmc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type sensorTester struct {
+	readings []SensorReading
+	err      error
+}
+
+func (s *sensorTester) SensorsRead(ctx context.Context) ([]SensorReading, error) {
+	return s.readings, s.err
+}
+
+func (s *sensorTester) Name() string {
+	return "test provider"
+}
+
+func TestReadSensorsFromInterfaces(t *testing.T) {
+	reading, critical := 28.0, 90.0
+	readings := []SensorReading{
+		{
+			Name:       "CPU Temp",
+			Type:       SensorTypeTemperature,
+			Value:      &reading,
+			Unit:       SensorUnitCelsius,
+			Thresholds: SensorThresholds{UpperCritical: &critical},
+			Status:     SensorStatusOK,
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		providers []interface{}
+		want      []SensorReading
+		errMsg    string
+	}{
+		{
+			name:      "success",
+			providers: []interface{}{&sensorTester{readings: readings}},
+			want:      readings,
+		},
+		{
+			name:      "first provider fails",
+			providers: []interface{}{&sensorTester{err: errors.New("sdr not supported")}, &sensorTester{readings: readings}},
+			want:      readings,
+		},
+		{
+			name:      "all providers fail",
+			providers: []interface{}{&sensorTester{err: errors.New("sdr not supported")}},
+			errMsg:    "failed to read sensors",
+		},
+		{
+			name:      "no implementations",
+			providers: []interface{}{"foo"},
+			errMsg:    "no SensorReader implementations found",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, metadata, err := ReadSensorsFromInterfaces(context.Background(), time.Second, tc.providers)
+			if tc.errMsg != "" {
+				assert.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, "test provider", metadata.SuccessfulProvider)
+		})
+	}
+}
@@ -37,9 +37,13 @@ func deactivateSOL(ctx context.Context, timeout time.Duration, b []deactivatorPr
 			metadataLocal.ProvidersAttempted = append(metadataLocal.ProvidersAttempted, elem.name)
 			ctx, cancel := context.WithTimeout(ctx, timeout)
 			defer cancel()
-			newErr := elem.solDeactivator.DeactivateSOL(ctx)
+			start := time.Now()
+			newErr := metadataLocal.retry(ctx, elem.name, "DeactivateSOL", true, func() error {
+				return elem.solDeactivator.DeactivateSOL(ctx)
+			})
+			metadataLocal.setProviderDuration(elem.name, time.Since(start))
 			if newErr != nil {
-				err = multierror.Append(err, errors.WithMessagef(newErr, "provider: %v", elem.name))
+				err = multierror.Append(err, metadataLocal.providerFailed(elem.name, "DeactivateSOL", newErr))
 				continue
 			}
 			metadataLocal.SuccessfulProvider = elem.name
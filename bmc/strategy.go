@@ -0,0 +1,184 @@
+package bmc
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
+)
+
+// ExecutionStrategy defines how a method call is dispatched across the providers that implement it.
+type ExecutionStrategy string
+
+const (
+	// ExecutionSequential tries each provider in order until one succeeds, this is the default.
+	ExecutionSequential ExecutionStrategy = "sequential"
+	// ExecutionRace calls all providers concurrently and returns the first successful result,
+	// the calls to the remaining providers are canceled.
+	ExecutionRace ExecutionStrategy = "race"
+	// ExecutionCollectAll calls all providers concurrently and waits for all of them to return,
+	// the result of the first successful provider in registry order is returned.
+	ExecutionCollectAll ExecutionStrategy = "collect-all"
+	// ExecutionConsensus calls all providers concurrently like ExecutionCollectAll and compares
+	// their normalized results, the per provider results and whether they disagree are recorded
+	// in the Metadata ProviderResults and Conflict fields. The GetSystemEventLog columns differ
+	// between providers and are not compared, the call is made like with ExecutionCollectAll.
+	ExecutionConsensus ExecutionStrategy = "consensus"
+)
+
+type executionStrategyCtxKey struct{}
+
+// WithExecutionStrategy returns a context that carries the given execution strategy.
+//
+// The strategy is only applied to read only methods that support it,
+// other methods always try providers sequentially.
+func WithExecutionStrategy(ctx context.Context, strategy ExecutionStrategy) context.Context {
+	return context.WithValue(ctx, executionStrategyCtxKey{}, strategy)
+}
+
+// ExecutionStrategyFromContext returns the execution strategy carried by the context,
+// and a bool indicating if the context carried a strategy.
+func ExecutionStrategyFromContext(ctx context.Context) (ExecutionStrategy, bool) {
+	strategy, ok := ctx.Value(executionStrategyCtxKey{}).(ExecutionStrategy)
+	if !ok || strategy == "" {
+		return ExecutionSequential, false
+	}
+
+	return strategy, true
+}
+
+// concurrent returns true when the strategy dispatches calls to providers concurrently.
+func (e ExecutionStrategy) concurrent() bool {
+	switch e {
+	case ExecutionRace, ExecutionCollectAll, ExecutionConsensus:
+		return true
+	default:
+		return false
+	}
+}
+
+// providerCall correlates a provider name with a method call on the provider.
+type providerCall[T any] struct {
+	name string
+	call func(ctx context.Context) (T, error)
+}
+
+// normalizer returns a comparable representation of a provider result,
+// used by the consensus strategy to compare results across providers.
+type normalizer[T any] func(T) string
+
+// providerResult holds the outcome of a providerCall.
+type providerResult[T any] struct {
+	index    int
+	value    T
+	err      error
+	duration time.Duration
+	attempts int
+}
+
+// runConcurrent calls the given providers concurrently as per the strategy.
+//
+// operation is the provider method called, used to identify errors returned by the providers.
+// timeout is applied to each provider call, a zero timeout leaves the context deadline as is.
+// failMsg is appended to the returned error when no provider succeeds.
+// normalize is used by the consensus strategy to compare the provider results.
+func runConcurrent[T any](ctx context.Context, timeout time.Duration, strategy ExecutionStrategy, operation string, calls []providerCall[T], normalize normalizer[T], failMsg string) (result T, metadata Metadata, err error) {
+	metadata = newMetadata()
+
+	select {
+	case <-ctx.Done():
+		return result, metadata, multierror.Append(err, ctx.Err())
+	default:
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// buffered to the number of calls so goroutines of canceled calls
+	// don't block once a race has been won.
+	resultCh := make(chan providerResult[T], len(calls))
+	for idx, elem := range calls {
+		metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
+
+		go func(idx int, elem providerCall[T]) {
+			callCtx := ctx
+			if timeout > 0 {
+				var callCancel context.CancelFunc
+				callCtx, callCancel = context.WithTimeout(ctx, timeout)
+				defer callCancel()
+			}
+
+			var value T
+			start := time.Now()
+			attempts, callErr := retry(callCtx, operation, true, func() (err error) {
+				value, err = elem.call(callCtx)
+				return err
+			})
+			resultCh <- providerResult[T]{index: idx, value: value, err: callErr, duration: time.Since(start), attempts: attempts}
+		}(idx, elem)
+	}
+
+	results := make([]*providerResult[T], len(calls))
+	for range calls {
+		res := <-resultCh
+		results[res.index] = &res
+		metadata.setProviderDuration(calls[res.index].name, res.duration)
+		if res.attempts > 1 {
+			metadata.setProviderAttempts(calls[res.index].name, res.attempts)
+		}
+
+		if res.err != nil {
+			metadata.FailedProviderDetail[calls[res.index].name] = res.err.Error()
+			continue
+		}
+
+		if strategy == ExecutionRace {
+			metadata.SuccessfulProvider = calls[res.index].name
+			return res.value, metadata, nil
+		}
+	}
+
+	if strategy == ExecutionConsensus {
+		metadata.ProviderResults = make(map[string]string)
+		for idx, res := range results {
+			if res.err == nil {
+				metadata.ProviderResults[calls[idx].name] = normalize(res.value)
+			}
+		}
+
+		metadata.Conflict = conflicting(metadata.ProviderResults)
+	}
+
+	// with all results collected, the first successful provider in order is returned
+	// and errors are reported in provider order for a consistent error message.
+	for idx, res := range results {
+		if res.err != nil {
+			err = multierror.Append(err, metadata.providerFailed(calls[idx].name, operation, res.err))
+			continue
+		}
+
+		metadata.SuccessfulProvider = calls[idx].name
+		return res.value, metadata, nil
+	}
+
+	return result, metadata, multierror.Append(err, errors.New(failMsg))
+}
+
+// conflicting returns true when the given provider results are not all the same.
+func conflicting(results map[string]string) bool {
+	var first string
+	var seen bool
+	for _, result := range results {
+		if !seen {
+			first, seen = result, true
+			continue
+		}
+
+		if result != first {
+			return true
+		}
+	}
+
+	return false
+}
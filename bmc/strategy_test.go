@@ -0,0 +1,275 @@
+package bmc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	common "github.com/metal-toolbox/bmc-common"
+	"github.com/stretchr/testify/assert"
+)
+
+type delayedPowerStateGetter struct {
+	name  string
+	delay time.Duration
+	state string
+	err   error
+}
+
+func (d *delayedPowerStateGetter) PowerStateGet(ctx context.Context) (string, error) {
+	select {
+	case <-ctx.Done():
+		return "", ctx.Err()
+	case <-time.After(d.delay):
+		return d.state, d.err
+	}
+}
+
+func (d *delayedPowerStateGetter) Name() string {
+	return d.name
+}
+
+func TestGetPowerStateExecutionStrategy(t *testing.T) {
+	testCases := []struct {
+		name               string
+		strategy           ExecutionStrategy
+		providers          []interface{}
+		wantState          string
+		wantProvider       string
+		wantDurations      []string
+		wantErr            string
+		wantFailedProvider []string
+	}{
+		{
+			name:     "sequential tries providers in order",
+			strategy: ExecutionSequential,
+			providers: []interface{}{
+				&delayedPowerStateGetter{name: "slow", delay: 50 * time.Millisecond, state: "on"},
+				&delayedPowerStateGetter{name: "fast", state: "off"},
+			},
+			wantState:     "on",
+			wantProvider:  "slow",
+			wantDurations: []string{"slow"},
+		},
+		{
+			name:     "race returns the first successful provider",
+			strategy: ExecutionRace,
+			providers: []interface{}{
+				&delayedPowerStateGetter{name: "slow", delay: time.Second, state: "on"},
+				&delayedPowerStateGetter{name: "failing", err: errors.New("boom")},
+				&delayedPowerStateGetter{name: "fast", delay: 10 * time.Millisecond, state: "off"},
+			},
+			wantState:          "off",
+			wantProvider:       "fast",
+			wantDurations:      []string{"failing", "fast"},
+			wantFailedProvider: []string{"failing"},
+		},
+		{
+			name:     "collect all returns the first successful provider in order",
+			strategy: ExecutionCollectAll,
+			providers: []interface{}{
+				&delayedPowerStateGetter{name: "failing", err: errors.New("boom")},
+				&delayedPowerStateGetter{name: "slow", delay: 50 * time.Millisecond, state: "on"},
+				&delayedPowerStateGetter{name: "fast", state: "off"},
+			},
+			wantState:          "on",
+			wantProvider:       "slow",
+			wantDurations:      []string{"failing", "slow", "fast"},
+			wantFailedProvider: []string{"failing"},
+		},
+		{
+			name:     "race with all providers failing",
+			strategy: ExecutionRace,
+			providers: []interface{}{
+				&delayedPowerStateGetter{name: "one", err: errors.New("boom")},
+				&delayedPowerStateGetter{name: "two", delay: 10 * time.Millisecond, err: errors.New("bang")},
+			},
+			wantErr:            "3 errors occurred:\n\t* provider: one: boom\n\t* provider: two: bang\n\t* failed to get power state\n\n",
+			wantDurations:      []string{"one", "two"},
+			wantFailedProvider: []string{"one", "two"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := WithExecutionStrategy(context.Background(), tc.strategy)
+
+			state, metadata, err := GetPowerStateFromInterfaces(ctx, 5*time.Second, tc.providers)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+			} else {
+				assert.Nil(t, err)
+			}
+
+			assert.Equal(t, tc.wantState, state)
+			assert.Equal(t, tc.wantProvider, metadata.SuccessfulProvider)
+
+			for _, p := range tc.wantDurations {
+				assert.Contains(t, metadata.ProviderDurations, p)
+			}
+
+			for _, p := range tc.wantFailedProvider {
+				assert.Contains(t, metadata.FailedProviderDetail, p)
+			}
+		})
+	}
+}
+
+func TestConsensusExecutionStrategy(t *testing.T) {
+	testCases := []struct {
+		name            string
+		providers       []interface{}
+		wantState       string
+		wantResults     map[string]string
+		wantConflict    bool
+		wantSuccessful  string
+		wantFailedCount int
+	}{
+		{
+			name: "providers agree",
+			providers: []interface{}{
+				&delayedPowerStateGetter{name: "ipmitool", state: "Chassis Power is on"},
+				&delayedPowerStateGetter{name: "gofish", delay: 10 * time.Millisecond, state: "On"},
+			},
+			wantState:      "on",
+			wantResults:    map[string]string{"ipmitool": "on", "gofish": "on"},
+			wantSuccessful: "ipmitool",
+		},
+		{
+			name: "providers disagree",
+			providers: []interface{}{
+				&delayedPowerStateGetter{name: "ipmitool", delay: 10 * time.Millisecond, state: "Chassis Power is off"},
+				&delayedPowerStateGetter{name: "gofish", state: "On"},
+			},
+			wantState:      "off",
+			wantResults:    map[string]string{"ipmitool": "off", "gofish": "on"},
+			wantConflict:   true,
+			wantSuccessful: "ipmitool",
+		},
+		{
+			name: "failed providers are excluded",
+			providers: []interface{}{
+				&delayedPowerStateGetter{name: "ipmitool", err: errors.New("boom")},
+				&delayedPowerStateGetter{name: "gofish", state: "Off"},
+			},
+			wantState:       "off",
+			wantResults:     map[string]string{"gofish": "off"},
+			wantSuccessful:  "gofish",
+			wantFailedCount: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := WithExecutionStrategy(context.Background(), ExecutionConsensus)
+
+			// the providers report the states in their own format, they are compared normalized.
+			state, metadata, err := PowerStateFromInterfaces(ctx, 5*time.Second, tc.providers)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.wantState, string(state))
+			assert.Equal(t, tc.wantResults, metadata.ProviderResults)
+			assert.Equal(t, tc.wantConflict, metadata.Conflict)
+			assert.Equal(t, tc.wantSuccessful, metadata.SuccessfulProvider)
+			assert.Equal(t, tc.wantFailedCount, len(metadata.FailedProviderDetail))
+		})
+	}
+}
+
+type namedBootDeviceOverrideGetter struct {
+	name     string
+	override BootDeviceOverride
+}
+
+func (n *namedBootDeviceOverrideGetter) BootDeviceOverrideGet(_ context.Context) (BootDeviceOverride, error) {
+	return n.override, nil
+}
+
+func (n *namedBootDeviceOverrideGetter) Name() string {
+	return n.name
+}
+
+func TestConsensusBootDeviceOverride(t *testing.T) {
+	ctx := WithExecutionStrategy(context.Background(), ExecutionConsensus)
+	providers := []interface{}{
+		&namedBootDeviceOverrideGetter{name: "ipmitool", override: BootDeviceOverride{Device: BootDeviceTypePXE, IsEFIBoot: true}},
+		&namedBootDeviceOverrideGetter{name: "gofish", override: BootDeviceOverride{Device: BootDeviceTypeDisk, IsEFIBoot: true}},
+	}
+
+	override, metadata, err := GetBootDeviceOverrideFromInterface(ctx, 5*time.Second, providers)
+	assert.Nil(t, err)
+	assert.Equal(t, BootDeviceTypePXE, override.Device)
+	assert.Equal(t, "ipmitool", metadata.SuccessfulProvider)
+	assert.True(t, metadata.Conflict)
+	assert.Equal(
+		t,
+		map[string]string{
+			"ipmitool": "device=pxe,persistent=false,efi=true",
+			"gofish":   "device=disk,persistent=false,efi=true",
+		},
+		metadata.ProviderResults,
+	)
+}
+
+type namedInventoryGetter struct {
+	name   string
+	device *common.Device
+}
+
+func (n *namedInventoryGetter) Inventory(_ context.Context) (*common.Device, error) {
+	return n.device, nil
+}
+
+func (n *namedInventoryGetter) Name() string {
+	return n.name
+}
+
+func TestConsensusInventory(t *testing.T) {
+	ctx := WithExecutionStrategy(context.Background(), ExecutionConsensus)
+
+	device := func(vendor, serial, bios string) *common.Device {
+		return &common.Device{
+			Common: common.Common{Vendor: vendor, Serial: serial},
+			BIOS:   &common.BIOS{Common: common.Common{Firmware: &common.Firmware{Installed: bios}}},
+		}
+	}
+
+	// the details reported differently by each provider are not compared
+	agree := []interface{}{
+		&namedInventoryGetter{name: "ipmitool", device: device("Dell Inc.", "ABC123", "2.19.1")},
+		&namedInventoryGetter{name: "gofish", device: device("dell", "ABC123", "2.19.1")},
+	}
+
+	_, metadata, err := GetInventoryFromInterfaces(ctx, agree)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(metadata.ProviderResults))
+	assert.Equal(t, "serial=ABC123 bios=2.19.1 bmc=", metadata.ProviderResults["gofish"])
+	assert.False(t, metadata.Conflict)
+
+	disagree := []interface{}{
+		&namedInventoryGetter{name: "ipmitool", device: device("dell", "ABC123", "2.19.1")},
+		&namedInventoryGetter{name: "gofish", device: device("dell", "ABC123", "2.20.0")},
+	}
+
+	got, metadata, err := GetInventoryFromInterfaces(ctx, disagree)
+	assert.Nil(t, err)
+	assert.Equal(t, "2.19.1", got.BIOS.Firmware.Installed)
+	assert.Equal(t, "ipmitool", metadata.SuccessfulProvider)
+	assert.True(t, metadata.Conflict)
+}
+
+func TestSpanResult(t *testing.T) {
+	assert.Equal(t, "on", spanResult("on"))
+	assert.Equal(t, "sha256=", spanResult(strings.Repeat("x", maxSpanResultLen+1))[:7])
+}
+
+func TestExecutionStrategyFromContext(t *testing.T) {
+	strategy, ok := ExecutionStrategyFromContext(context.Background())
+	assert.False(t, ok)
+	assert.Equal(t, ExecutionSequential, strategy)
+
+	strategy, ok = ExecutionStrategyFromContext(WithExecutionStrategy(context.Background(), ExecutionRace))
+	assert.True(t, ok)
+	assert.Equal(t, ExecutionRace, strategy)
+}
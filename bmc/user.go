@@ -55,9 +55,15 @@ func createUser(ctx context.Context, timeout time.Duration, user, pass, role str
 			metadataLocal.ProvidersAttempted = append(metadataLocal.ProvidersAttempted, elem.name)
 			ctx, cancel := context.WithTimeout(ctx, timeout)
 			defer cancel()
-			ok, createErr := elem.userCreator.UserCreate(ctx, user, pass, role)
+			start := time.Now()
+			var ok bool
+			createErr := metadataLocal.retry(ctx, elem.name, "UserCreate", false, func() (err error) {
+				ok, err = elem.userCreator.UserCreate(ctx, user, pass, role)
+				return err
+			})
+			metadataLocal.setProviderDuration(elem.name, time.Since(start))
 			if createErr != nil {
-				err = multierror.Append(err, createErr)
+				err = multierror.Append(err, metadataLocal.providerFailed(elem.name, "UserCreate", createErr))
 				continue
 			}
 			if !ok {
@@ -108,9 +114,15 @@ func updateUser(ctx context.Context, timeout time.Duration, user, pass, role str
 			metadataLocal.ProvidersAttempted = append(metadataLocal.ProvidersAttempted, elem.name)
 			ctx, cancel := context.WithTimeout(ctx, timeout)
 			defer cancel()
-			ok, UpdateErr := elem.userUpdater.UserUpdate(ctx, user, pass, role)
+			start := time.Now()
+			var ok bool
+			UpdateErr := metadataLocal.retry(ctx, elem.name, "UserUpdate", true, func() (err error) {
+				ok, err = elem.userUpdater.UserUpdate(ctx, user, pass, role)
+				return err
+			})
+			metadataLocal.setProviderDuration(elem.name, time.Since(start))
 			if UpdateErr != nil {
-				err = multierror.Append(err, UpdateErr)
+				err = multierror.Append(err, metadataLocal.providerFailed(elem.name, "UserUpdate", UpdateErr))
 				continue
 			}
 			if !ok {
@@ -161,9 +173,15 @@ func deleteUser(ctx context.Context, timeout time.Duration, user string, u []use
 			metadataLocal.ProvidersAttempted = append(metadataLocal.ProvidersAttempted, elem.name)
 			ctx, cancel := context.WithTimeout(ctx, timeout)
 			defer cancel()
-			ok, deleteErr := elem.userDeleter.UserDelete(ctx, user)
+			start := time.Now()
+			var ok bool
+			deleteErr := metadataLocal.retry(ctx, elem.name, "UserDelete", true, func() (err error) {
+				ok, err = elem.userDeleter.UserDelete(ctx, user)
+				return err
+			})
+			metadataLocal.setProviderDuration(elem.name, time.Since(start))
 			if deleteErr != nil {
-				err = multierror.Append(err, deleteErr)
+				err = multierror.Append(err, metadataLocal.providerFailed(elem.name, "UserDelete", deleteErr))
 				continue
 			}
 			if !ok {
@@ -214,7 +232,13 @@ func readUsers(ctx context.Context, timeout time.Duration, u []userProviders) (u
 			metadataLocal.ProvidersAttempted = append(metadataLocal.ProvidersAttempted, elem.name)
 			ctx, cancel := context.WithTimeout(ctx, timeout)
 			defer cancel()
-			users, readErr := elem.userReader.UserRead(ctx)
+			start := time.Now()
+			var users []map[string]string
+			readErr := metadataLocal.retry(ctx, elem.name, "UserRead", true, func() (err error) {
+				users, err = elem.userReader.UserRead(ctx)
+				return err
+			})
+			metadataLocal.setProviderDuration(elem.name, time.Since(start))
 			if readErr != nil {
 				err = multierror.Append(err, readErr)
 				continue
@@ -8,6 +8,8 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-multierror"
+
+	bmclibErrs "github.com/metal-toolbox/bmclib/errors"
 )
 
 type userTester struct {
@@ -342,6 +344,46 @@ func TestDeleteUserFromInterfaces(t *testing.T) {
 	}
 }
 
+func TestUserProviderErrors(t *testing.T) {
+	providers := []userProviders{{"test provider", &userTester{MakeErrorOut: true}, &userTester{MakeErrorOut: true}, &userTester{MakeErrorOut: true}, nil}}
+	testCases := map[string]struct {
+		operation string
+		call      func() (Metadata, error)
+	}{
+		"create": {"UserCreate", func() (Metadata, error) {
+			_, m, err := createUser(context.Background(), time.Second, "ADMIN", "ADMIN", "admin", providers)
+			return m, err
+		}},
+		"update": {"UserUpdate", func() (Metadata, error) {
+			_, m, err := updateUser(context.Background(), time.Second, "ADMIN", "ADMIN", "admin", providers)
+			return m, err
+		}},
+		"delete": {"UserDelete", func() (Metadata, error) {
+			_, m, err := deleteUser(context.Background(), time.Second, "ADMIN", providers)
+			return m, err
+		}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			metadata, err := tc.call()
+
+			var pErr *bmclibErrs.ProviderError
+			if !errors.As(err, &pErr) {
+				t.Fatalf("expected a ProviderError in the error chain: %v", err)
+			}
+
+			if diff := cmp.Diff([]string{"test provider", tc.operation}, []string{pErr.Provider, pErr.Operation}); diff != "" {
+				t.Fatal(diff)
+			}
+
+			if metadata.FailedProviderErrors["test provider"] != pErr {
+				t.Fatal("expected the ProviderError to be recorded in the metadata")
+			}
+		})
+	}
+}
+
 func TestReadUsers(t *testing.T) {
 	testCases := map[string]struct {
 		makeErrorOut bool
@@ -3,6 +3,7 @@ package bmc
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/pkg/errors"
@@ -34,9 +35,15 @@ func setVirtualMedia(ctx context.Context, kind string, mediaURL string, b []virt
 			return false, metadata, err
 		default:
 			metadataLocal.ProvidersAttempted = append(metadataLocal.ProvidersAttempted, elem.name)
-			ok, setErr := elem.virtualMediaSetter.SetVirtualMedia(ctx, kind, mediaURL)
+			start := time.Now()
+			var ok bool
+			setErr := metadataLocal.retry(ctx, elem.name, "SetVirtualMedia", true, func() (err error) {
+				ok, err = elem.virtualMediaSetter.SetVirtualMedia(ctx, kind, mediaURL)
+				return err
+			})
+			metadataLocal.setProviderDuration(elem.name, time.Since(start))
 			if setErr != nil {
-				err = multierror.Append(err, errors.WithMessagef(setErr, "provider: %v", elem.name))
+				err = multierror.Append(err, metadataLocal.providerFailed(elem.name, "SetVirtualMedia", setErr))
 				continue
 			}
 			if !ok {
@@ -0,0 +1,91 @@
+package bmclib
+
+import (
+	"context"
+	"sync"
+
+	"github.com/jacobweinstock/registrar"
+	"github.com/metal-toolbox/bmclib/bmc"
+)
+
+// capabilityReport holds the capabilities last probed by Client.Capabilities, keyed by the provider name.
+type capabilityReport struct {
+	mu         sync.RWMutex
+	byProvider map[string]bmc.Capabilities
+}
+
+func (r *capabilityReport) set(capabilities map[string]bmc.Capabilities) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.byProvider = capabilities
+}
+
+func (r *capabilityReport) get(provider string) (bmc.Capabilities, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	caps, ok := r.byProvider[provider]
+	return caps, ok
+}
+
+// Capabilities probes the providers that implement bmc.CapabilityProber for the features the BMC supports,
+// it is expected to be called after Open.
+//
+// The returned report is keyed by provider name and includes all providers in the registry,
+// providers that could not be probed report the features they declare with Probed set to false.
+//
+// The probed capabilities are kept on the Client and used by the Supports method to exclude providers
+// that were found not to support the requested features on this BMC.
+// An error is returned when probing a provider failed, along with the report.
+func (c *Client) Capabilities(ctx context.Context) (map[string]bmc.Capabilities, error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "Capabilities")
+	defer span.End()
+
+	ctx = c.withCallOptions(ctx)
+
+	registry := c.registry()
+	probed, metadata, err := bmc.ProbeCapabilitiesFromInterfaces(ctx, c.perProviderTimeout(ctx), registry.GetDriverInterfaces())
+	c.setMetadata(ctx, metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	report := make(map[string]bmc.Capabilities, len(registry.Drivers))
+	for _, driver := range registry.Drivers {
+		if caps, ok := probed[driver.Name]; ok {
+			report[driver.Name] = caps
+			continue
+		}
+
+		report[driver.Name] = bmc.Capabilities{Features: driver.Features}
+	}
+
+	c.capabilityReport().set(probed)
+
+	return report, err
+}
+
+// capabilityReport returns the capability report shared by the Client and its views.
+func (c *Client) capabilityReport() *capabilityReport {
+	root := c.rootClient()
+	if root.capabilities == nil {
+		root.capabilities = &capabilityReport{}
+	}
+
+	return root.capabilities
+}
+
+// supportedAtRuntime removes the drivers that were probed and found not to support the given features.
+func (c *Client) supportedAtRuntime(drivers registrar.Drivers, features ...registrar.Feature) registrar.Drivers {
+	report := c.capabilityReport()
+
+	supported := registrar.Drivers{}
+	for _, driver := range drivers {
+		if caps, ok := report.get(driver.Name); ok && !caps.Supports(features...) {
+			continue
+		}
+
+		supported = append(supported, driver)
+	}
+
+	return supported
+}
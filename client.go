@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -18,7 +19,9 @@ import (
 	common "github.com/metal-toolbox/bmc-common"
 	"github.com/metal-toolbox/bmclib/bmc"
 	"github.com/metal-toolbox/bmclib/constants"
+	"github.com/metal-toolbox/bmclib/credentials"
 	"github.com/metal-toolbox/bmclib/internal/httpclient"
+	"github.com/metal-toolbox/bmclib/providers"
 	"github.com/metal-toolbox/bmclib/providers/asrockrack"
 	"github.com/metal-toolbox/bmclib/providers/dell"
 	"github.com/metal-toolbox/bmclib/providers/intelamt"
@@ -44,15 +47,27 @@ type Client struct {
 	Logger   logr.Logger
 	Registry *registrar.Registry
 
-	httpClient             *http.Client
-	httpClientSetupFuncs   []func(*http.Client)
-	mdLock                 *sync.Mutex
-	metadata               *bmc.Metadata
-	perProviderTimeout     func(context.Context) time.Duration
-	oneTimeRegistry        *registrar.Registry
-	oneTimeRegistryEnabled bool
-	providerConfig         providerConfig
-	traceprovider          oteltrace.TracerProvider
+	httpClient               *http.Client
+	httpClientSetupFuncs     []func(*http.Client)
+	mdLock                   *sync.Mutex
+	registryLock             *sync.RWMutex
+	connLock                 *sync.Mutex
+	connected                map[*registrar.Driver]bool
+	metadata                 *bmc.Metadata
+	executionStrategy        bmc.ExecutionStrategy
+	retryPolicy              *bmc.RetryPolicy
+	perProviderTimeout       func(context.Context) time.Duration
+	root                     *Client
+	scopes                   []scope
+	capabilities             *capabilityReport
+	providerFactories        []providers.Factory
+	credentialProvider       CredentialProvider
+	fallbackCredentials      []credentials.Credentials
+	registrySupplied         bool
+	disabledBuiltinProviders []string
+	disableBuiltinProviders  bool
+	providerConfig           providerConfig
+	traceprovider            oteltrace.TracerProvider
 }
 
 // Auth details for connecting to a BMC
@@ -77,12 +92,10 @@ type providerConfig struct {
 // NewClient returns a new Client struct
 func NewClient(host, user, pass string, opts ...Option) *Client {
 	defaultClient := &Client{
-		Logger:                 logr.Discard(),
-		Registry:               registrar.NewRegistry(),
-		oneTimeRegistryEnabled: false,
-		oneTimeRegistry:        registrar.NewRegistry(),
-		httpClient:             httpclient.Build(),
-		traceprovider:          tracenoop.NewTracerProvider(),
+		Logger:        logr.Discard(),
+		Registry:      registrar.NewRegistry(),
+		httpClient:    httpclient.Build(),
+		traceprovider: tracenoop.NewTracerProvider(),
 		providerConfig: providerConfig{
 			ipmitool: ipmitool.Config{
 				Port: "623",
@@ -126,8 +139,15 @@ func NewClient(host, user, pass string, opts ...Option) *Client {
 	// len of 0 means that no Registry, with any registered providers, was passed in.
 	if len(defaultClient.Registry.Drivers) == 0 {
 		defaultClient.registerProviders()
+	} else {
+		defaultClient.registrySupplied = true
 	}
+	defaultClient.registerExternalProviders()
 	defaultClient.mdLock = &sync.Mutex{}
+	defaultClient.registryLock = &sync.RWMutex{}
+	defaultClient.connLock = &sync.Mutex{}
+	defaultClient.metadata = &bmc.Metadata{}
+	defaultClient.capabilities = &capabilityReport{}
 	if defaultClient.perProviderTimeout == nil {
 		defaultClient.perProviderTimeout = defaultClient.defaultTimeout
 	}
@@ -266,30 +286,93 @@ func (c *Client) registerOpenBMCProvider() {
 func (c *Client) registerProviders() {
 	// register the rpc provider
 	// without the consumer URL there is no way to send RPC requests.
-	if c.providerConfig.rpc.ConsumerURL != "" {
-		// when the rpc provider is to be used, we won't register any other providers.
+	if c.providerConfig.rpc.ConsumerURL != "" && c.builtinProviderEnabled(rpc.ProviderName) {
+		// when the rpc provider is to be used, we won't register any other built-in providers.
 		err := c.registerRPCProvider()
 		if err == nil {
-			c.Logger.Info("note: with the rpc provider registered, no other providers will be registered and available")
+			c.Logger.Info("note: with the rpc provider registered, no other built-in providers will be registered and available")
 			return
 		}
 		c.Logger.Info("failed to register rpc provider, falling back to registering all other providers", "error", err.Error())
 	}
 
-	if err := c.registerIPMIProvider(); err != nil {
-		c.Logger.Info("ipmitool provider not available", "error", err.Error())
+	builtin := []struct {
+		name     string
+		register func() error
+	}{
+		{ipmitool.ProviderName, c.registerIPMIProvider},
+		{asrockrack.ProviderName, func() error { c.registerASRRProvider(); return nil }},
+		{redfish.ProviderName, func() error { c.registerGofishProvider(); return nil }},
+		{intelamt.ProviderName, func() error { c.registerIntelAMTProvider(); return nil }},
+		{dell.ProviderName, func() error { c.registerDellProvider(); return nil }},
+		{supermicro.ProviderName, func() error { c.registerSupermicroProvider(); return nil }},
+		{openbmc.ProviderName, func() error { c.registerOpenBMCProvider(); return nil }},
 	}
 
-	c.registerASRRProvider()
-	c.registerGofishProvider()
-	c.registerIntelAMTProvider()
-	c.registerDellProvider()
-	c.registerSupermicroProvider()
-	c.registerOpenBMCProvider()
+	for _, p := range builtin {
+		if !c.builtinProviderEnabled(p.name) {
+			continue
+		}
+
+		if err := p.register(); err != nil {
+			c.Logger.Info(p.name+" provider not available", "error", err.Error())
+		}
+	}
+}
+
+// builtinProviderEnabled returns false when the built-in provider was disabled with WithoutBuiltinProviders.
+func (c *Client) builtinProviderEnabled(name string) bool {
+	if c.disableBuiltinProviders {
+		return false
+	}
+
+	for _, disabled := range c.disabledBuiltinProviders {
+		if disabled == name {
+			return false
+		}
+	}
+
+	return true
+}
+
+// registerExternalProviders registers the providers constructed by the factories set with WithProviders.
+func (c *Client) registerExternalProviders() {
+	for _, factory := range c.providerFactories {
+		httpClient := *c.httpClient
+		if transport, ok := c.httpClient.Transport.(*http.Transport); ok {
+			httpClient.Transport = transport.Clone()
+		}
+
+		driver, err := factory.New(providers.FactoryConfig{
+			Host:       c.Auth.Host,
+			User:       c.Auth.User,
+			Pass:       c.Auth.Pass,
+			HTTPClient: &httpClient,
+			Logger:     c.Logger,
+		})
+		if err != nil {
+			c.Logger.Info(factory.Name()+" provider not available", "error", err.Error())
+			continue
+		}
+
+		c.Registry.Register(factory.Name(), factory.Protocol(), factory.Features(), nil, driver)
+	}
 }
 
-// GetMetadata returns the metadata that is populated after each BMC function/method call
+// GetMetadata returns the metadata that is populated after each BMC function/method call.
+//
+// The metadata is shared by all calls made on the Client, when methods are called concurrently
+// use WithCallMetadata to obtain the metadata of each call.
 func (c *Client) GetMetadata() bmc.Metadata {
+	if c.root != nil {
+		return c.root.GetMetadata()
+	}
+
+	if c.mdLock != nil {
+		c.mdLock.Lock()
+		defer c.mdLock.Unlock()
+	}
+
 	if c.metadata != nil {
 		return *c.metadata
 	}
@@ -297,8 +380,18 @@ func (c *Client) GetMetadata() bmc.Metadata {
 }
 
 // setMetadata wraps setting metadata with a mutex for cases where users are
-// making calls to multiple *Client.X functions/methods across goroutines
-func (c *Client) setMetadata(metadata bmc.Metadata) {
+// making calls to multiple *Client.X functions/methods across goroutines,
+// the metadata is also delivered to any CallMetadata carried by the context.
+func (c *Client) setMetadata(ctx context.Context, metadata bmc.Metadata) {
+	if c.root != nil {
+		c.root.setMetadata(ctx, metadata)
+		return
+	}
+
+	if cm, ok := ctx.Value(callMetadataCtxKey{}).(*CallMetadata); ok {
+		cm.add(metadata)
+	}
+
 	// a mutex is created with the NewClient func, in the case
 	// where a user doesn't call NewClient we handle by checking if
 	// the mutex is nil
@@ -306,17 +399,113 @@ func (c *Client) setMetadata(metadata bmc.Metadata) {
 		c.mdLock.Lock()
 		defer c.mdLock.Unlock()
 	}
-	c.metadata = &metadata
+
+	// the metadata is updated in place so the field is not written while a Client view is created from it.
+	if c.metadata == nil {
+		c.metadata = &metadata
+		return
+	}
+	*c.metadata = metadata
 }
 
-// registry will return the oneTimeRegistry if the oneTimeRegistryEnabled is true.
+// registry returns a snapshot of the Client registry with the scopes of a Client view applied.
 func (c *Client) registry() *registrar.Registry {
-	if c.oneTimeRegistryEnabled {
-		c.oneTimeRegistryEnabled = false
-		return c.oneTimeRegistry
+	drivers := c.drivers()
+	for _, s := range c.scopes {
+		drivers = s(registrar.Registry{Logger: c.Registry.Logger, Drivers: drivers})
+	}
+
+	return &registrar.Registry{Logger: c.Registry.Logger, Drivers: drivers}
+}
+
+// drivers returns a copy of the registry drivers, the registry is shared by the Client and its views.
+func (c *Client) drivers() registrar.Drivers {
+	// the locks are created with the NewClient func, in the case
+	// where a user doesn't call NewClient we handle by checking if
+	// the lock is nil
+	if c.registryLock != nil {
+		c.registryLock.RLock()
+		defer c.registryLock.RUnlock()
+	}
+
+	return slices.Clone(c.Registry.Drivers)
+}
+
+// setDrivers replaces the registry drivers shared by the Client and its views.
+func (c *Client) setDrivers(drivers registrar.Drivers) {
+	if c.registryLock != nil {
+		c.registryLock.Lock()
+		defer c.registryLock.Unlock()
+	}
+
+	c.Registry.Drivers = drivers
+}
+
+// setConnected records whether the drivers have an open connection, the record is kept on the Client
+// views are created from so the connections opened with a view are closed when the drivers are replaced.
+func (c *Client) setConnected(drivers registrar.Drivers, connected bool) {
+	root := c.rootClient()
+	if root.registryLock != nil {
+		root.registryLock.Lock()
+		defer root.registryLock.Unlock()
+	}
+
+	if root.connected == nil {
+		root.connected = make(map[*registrar.Driver]bool)
 	}
 
-	return c.Registry
+	for _, driver := range drivers {
+		if connected {
+			root.connected[driver] = true
+			continue
+		}
+
+		delete(root.connected, driver)
+	}
+}
+
+// connectedDrivers returns the given drivers that have an open connection.
+func (c *Client) connectedDrivers(drivers registrar.Drivers) registrar.Drivers {
+	root := c.rootClient()
+	if root.registryLock != nil {
+		root.registryLock.RLock()
+		defer root.registryLock.RUnlock()
+	}
+
+	var connected registrar.Drivers
+	for _, driver := range drivers {
+		if root.connected[driver] {
+			connected = append(connected, driver)
+		}
+	}
+
+	return connected
+}
+
+// lockConnections serializes the calls that open connections and replace the registry drivers,
+// like Open and RotateCredentials, across the Client and its views. The returned func releases the lock.
+func (c *Client) lockConnections() func() {
+	if c.connLock == nil {
+		return func() {}
+	}
+
+	c.connLock.Lock()
+
+	return c.connLock.Unlock
+}
+
+// withCallOptions returns a context carrying the Client execution strategy and retry policy,
+// unless the given context already carries them for the call.
+func (c *Client) withCallOptions(ctx context.Context) context.Context {
+	if _, ok := bmc.ExecutionStrategyFromContext(ctx); !ok && c.executionStrategy != "" {
+		ctx = bmc.WithExecutionStrategy(ctx, c.executionStrategy)
+	}
+
+	if _, ok := bmc.RetryPolicyFromContext(ctx); !ok && c.retryPolicy != nil {
+		ctx = bmc.WithRetryPolicy(ctx, *c.retryPolicy)
+	}
+
+	return ctx
 }
 
 func (c *Client) RegisterSpanAttributes(m bmc.Metadata, span oteltrace.Span) {
@@ -347,28 +536,91 @@ func (c *Client) RegisterSpanAttributes(m bmc.Metadata, span oteltrace.Span) {
 // Any providers/drivers that do not successfully connect are removed
 // from the client.Registry.Drivers. If client.Registry.Drivers ends up
 // being empty then we error.
+//
+// When a CredentialProvider is set with WithCredentialProvider, the credentials are requested
+// from it before connecting and again when a provider fails to authenticate,
+// the providers are then recreated and the connections opened with the updated credentials.
+//
+// When fallback credentials are set with WithFallbackCredentials, the providers that fail to authenticate
+// are opened with each of them in turn, the credentials each provider was opened with are reported in the
+// Metadata ProviderCredentials field.
 func (c *Client) Open(ctx context.Context) error {
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "Open")
 	defer span.End()
 
-	ifs, metadata, err := bmc.OpenConnectionFromInterfaces(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
+	ctx = c.withCallOptions(ctx)
+
+	defer c.lockConnections()()
+
+	if c.credentialProvider != nil {
+		changed, err := c.refreshCredentials(ctx)
+		if err != nil {
+			return err
+		}
+
+		if changed {
+			c.rebuildProviders(ctx)
+		}
+	}
+
+	drivers := c.drivers()
+	order := make([]string, 0, len(drivers))
+	for _, driver := range drivers {
+		order = append(order, driver.Name)
+	}
+
+	metadata, err := c.open(ctx)
+	if c.credentialProvider != nil && authFailed(metadata) {
+		metadata, err = c.reopen(ctx, metadata, err)
+	}
+
+	if len(c.fallbackCredentials) > 0 && !c.registrySupplied {
+		metadata, err = c.openWithFallbackCredentials(ctx, order, metadata, err)
+	}
+
 	metadata.RegisterSpanAttributes(c.Auth.Host, span)
-	defer c.setMetadata(metadata)
+	c.setMetadata(ctx, metadata)
+
+	return err
+}
+
+// open opens the connections of the providers in the registry and removes the ones that failed.
+func (c *Client) open(ctx context.Context) (bmc.Metadata, error) {
+	ifs, metadata, err := bmc.OpenConnectionFromInterfaces(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
 	if err != nil {
-		return err
+		return metadata, err
 	}
 	var reg registrar.Drivers
-	for _, elem := range c.Registry.Drivers {
+	for _, elem := range c.drivers() {
 		for _, em := range ifs {
 			if em == elem.DriverInterface {
-				elem.DriverInterface = em
 				reg = append(reg, elem)
 			}
 		}
 	}
-	c.Registry.Drivers = reg
+	c.setDrivers(reg)
+	c.setConnected(reg, true)
 
-	return nil
+	return metadata, nil
+}
+
+// reopen requests the credentials again after a provider failed to authenticate,
+// when they were updated the connections that were opened are closed and all connections opened again.
+// The metadata and error of the first attempt are returned when the credentials are unchanged.
+func (c *Client) reopen(ctx context.Context, metadata bmc.Metadata, openErr error) (bmc.Metadata, error) {
+	changed, err := c.refreshCredentials(ctx)
+	if err != nil {
+		c.Logger.Info("failed to refresh credentials after an authentication failure", "error", err.Error())
+		return metadata, openErr
+	}
+
+	if !changed {
+		return metadata, openErr
+	}
+
+	c.rebuildProviders(ctx)
+
+	return c.open(ctx)
 }
 
 // Close pass through to library function
@@ -377,6 +629,8 @@ func (c *Client) Close(ctx context.Context) (err error) {
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "Close")
 	defer span.End()
 
+	ctx = c.withCallOptions(ctx)
+
 	// Generally, we always want the close function to run.
 	// We don't want a context timeout or cancellation to prevent this.
 	// But because the current model is to pass just a single context to all
@@ -384,11 +638,13 @@ func (c *Client) Close(ctx context.Context) (err error) {
 	// This is a short term solution, and we should consider a better/more holistic model.
 	if err := ctx.Err(); err != nil {
 		var done context.CancelFunc
-		ctx, done = context.WithTimeout(context.Background(), defaultConnectTimeout)
+		ctx, done = context.WithTimeout(context.WithoutCancel(ctx), defaultConnectTimeout)
 		defer done()
 	}
-	metadata, err := bmc.CloseConnectionFromInterfaces(ctx, c.registry().GetDriverInterfaces())
-	c.setMetadata(metadata)
+	registry := c.registry()
+	metadata, err := bmc.CloseConnectionFromInterfaces(ctx, registry.GetDriverInterfaces())
+	c.setConnected(registry.Drivers, false)
+	c.setMetadata(ctx, metadata)
 	metadata.RegisterSpanAttributes(c.Auth.Host, span)
 
 	return err
@@ -400,41 +656,85 @@ func (c *Client) FilterForCompatible(ctx context.Context) {
 	perProviderTimeout, cancel := context.WithTimeout(ctx, c.perProviderTimeout(ctx))
 	defer cancel()
 
+	defer c.lockConnections()()
+
 	reg := c.registry().FilterForCompatible(perProviderTimeout)
-	c.Registry.Drivers = reg
+	c.setDrivers(reg)
+}
+
+// PowerState returns the power state of the machine, normalized across providers.
+func (c *Client) PowerState(ctx context.Context) (bmc.PowerState, error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "PowerState")
+	defer span.End()
+
+	ctx = c.withCallOptions(ctx)
+
+	state, metadata, err := bmc.PowerStateFromInterfaces(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
+	if c.reauthenticate(ctx, metadata, err) {
+		state, metadata, err = bmc.PowerStateFromInterfaces(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return state, err
 }
 
-// GetPowerState pass through to library function
+// GetPowerState returns the power state of the machine as reported by the provider, see PowerState for the normalized state.
 func (c *Client) GetPowerState(ctx context.Context) (state string, err error) {
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "GetPowerState")
 	defer span.End()
 
+	ctx = c.withCallOptions(ctx)
+
 	state, metadata, err := bmc.GetPowerStateFromInterfaces(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
-	c.setMetadata(metadata)
+	if c.reauthenticate(ctx, metadata, err) {
+		state, metadata, err = bmc.GetPowerStateFromInterfaces(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
 	metadata.RegisterSpanAttributes(c.Auth.Host, span)
 
 	return state, err
 }
 
-// SetPowerState pass through to library function
-func (c *Client) SetPowerState(ctx context.Context, state string) (ok bool, err error) {
-	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "SetPowerState")
+// SetPowerAction performs the power action on the machine.
+func (c *Client) SetPowerAction(ctx context.Context, action bmc.PowerAction) (ok bool, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "SetPowerAction")
 	defer span.End()
 
-	ok, metadata, err := bmc.SetPowerStateFromInterfaces(ctx, c.perProviderTimeout(ctx), state, c.registry().GetDriverInterfaces())
-	c.setMetadata(metadata)
+	ctx = c.withCallOptions(ctx)
+
+	ok, metadata, err := bmc.SetPowerStateFromInterfaces(ctx, c.perProviderTimeout(ctx), string(action), c.registry().GetDriverInterfaces())
+	if c.reauthenticateMutation(ctx, metadata, err) {
+		ok, metadata, err = bmc.SetPowerStateFromInterfaces(ctx, c.perProviderTimeout(ctx), string(action), c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
 	metadata.RegisterSpanAttributes(c.Auth.Host, span)
 
 	return ok, err
 }
 
+// SetPowerState performs the power action given as a string, one of on, off, soft, reset or cycle, see SetPowerAction.
+func (c *Client) SetPowerState(ctx context.Context, state string) (ok bool, err error) {
+	action, err := bmc.ParsePowerAction(state)
+	if err != nil {
+		return false, err
+	}
+
+	return c.SetPowerAction(ctx, action)
+}
+
 // CreateUser pass through to library function
 func (c *Client) CreateUser(ctx context.Context, user, pass, role string) (ok bool, err error) {
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "CreateUser")
 	defer span.End()
 
+	ctx = c.withCallOptions(ctx)
+
 	ok, metadata, err := bmc.CreateUserFromInterfaces(ctx, c.perProviderTimeout(ctx), user, pass, role, c.registry().GetDriverInterfaces())
-	c.setMetadata(metadata)
+	if c.reauthenticateMutation(ctx, metadata, err) {
+		ok, metadata, err = bmc.CreateUserFromInterfaces(ctx, c.perProviderTimeout(ctx), user, pass, role, c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
 	metadata.RegisterSpanAttributes(c.Auth.Host, span)
 
 	return ok, err
@@ -445,8 +745,13 @@ func (c *Client) UpdateUser(ctx context.Context, user, pass, role string) (ok bo
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "UpdateUser")
 	defer span.End()
 
+	ctx = c.withCallOptions(ctx)
+
 	ok, metadata, err := bmc.UpdateUserFromInterfaces(ctx, c.perProviderTimeout(ctx), user, pass, role, c.registry().GetDriverInterfaces())
-	c.setMetadata(metadata)
+	if c.reauthenticateMutation(ctx, metadata, err) {
+		ok, metadata, err = bmc.UpdateUserFromInterfaces(ctx, c.perProviderTimeout(ctx), user, pass, role, c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
 	metadata.RegisterSpanAttributes(c.Auth.Host, span)
 
 	return ok, err
@@ -457,8 +762,13 @@ func (c *Client) DeleteUser(ctx context.Context, user string) (ok bool, err erro
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "DeleteUser")
 	defer span.End()
 
+	ctx = c.withCallOptions(ctx)
+
 	ok, metadata, err := bmc.DeleteUserFromInterfaces(ctx, c.perProviderTimeout(ctx), user, c.registry().GetDriverInterfaces())
-	c.setMetadata(metadata)
+	if c.reauthenticateMutation(ctx, metadata, err) {
+		ok, metadata, err = bmc.DeleteUserFromInterfaces(ctx, c.perProviderTimeout(ctx), user, c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
 	metadata.RegisterSpanAttributes(c.Auth.Host, span)
 
 	return ok, err
@@ -469,8 +779,13 @@ func (c *Client) ReadUsers(ctx context.Context) (users []map[string]string, err
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "ReadUsers")
 	defer span.End()
 
+	ctx = c.withCallOptions(ctx)
+
 	users, metadata, err := bmc.ReadUsersFromInterfaces(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
-	c.setMetadata(metadata)
+	if c.reauthenticate(ctx, metadata, err) {
+		users, metadata, err = bmc.ReadUsersFromInterfaces(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
 	metadata.RegisterSpanAttributes(c.Auth.Host, span)
 
 	return users, err
@@ -481,8 +796,13 @@ func (c *Client) GetBootDeviceOverride(ctx context.Context) (override bmc.BootDe
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "GetBootDeviceOverride")
 	defer span.End()
 
+	ctx = c.withCallOptions(ctx)
+
 	override, metadata, err := bmc.GetBootDeviceOverrideFromInterface(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
-	c.setMetadata(metadata)
+	if c.reauthenticate(ctx, metadata, err) {
+		override, metadata, err = bmc.GetBootDeviceOverrideFromInterface(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
 
 	return override, err
 }
@@ -492,8 +812,13 @@ func (c *Client) SetBootDevice(ctx context.Context, bootDevice string, setPersis
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "SetBootDevice")
 	defer span.End()
 
+	ctx = c.withCallOptions(ctx)
+
 	ok, metadata, err := bmc.SetBootDeviceFromInterfaces(ctx, c.perProviderTimeout(ctx), bootDevice, setPersistent, efiBoot, c.registry().GetDriverInterfaces())
-	c.setMetadata(metadata)
+	if c.reauthenticateMutation(ctx, metadata, err) {
+		ok, metadata, err = bmc.SetBootDeviceFromInterfaces(ctx, c.perProviderTimeout(ctx), bootDevice, setPersistent, efiBoot, c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
 	metadata.RegisterSpanAttributes(c.Auth.Host, span)
 
 	return ok, err
@@ -507,8 +832,13 @@ func (c *Client) SetVirtualMedia(ctx context.Context, kind string, mediaURL stri
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "SetVirtualMedia")
 	defer span.End()
 
+	ctx = c.withCallOptions(ctx)
+
 	ok, metadata, err := bmc.SetVirtualMediaFromInterfaces(ctx, kind, mediaURL, c.registry().GetDriverInterfaces())
-	c.setMetadata(metadata)
+	if c.reauthenticateMutation(ctx, metadata, err) {
+		ok, metadata, err = bmc.SetVirtualMediaFromInterfaces(ctx, kind, mediaURL, c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
 	metadata.RegisterSpanAttributes(c.Auth.Host, span)
 
 	return ok, err
@@ -519,8 +849,13 @@ func (c *Client) ResetBMC(ctx context.Context, resetType string) (ok bool, err e
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "ResetBMC")
 	defer span.End()
 
+	ctx = c.withCallOptions(ctx)
+
 	ok, metadata, err := bmc.ResetBMCFromInterfaces(ctx, c.perProviderTimeout(ctx), resetType, c.registry().GetDriverInterfaces())
-	c.setMetadata(metadata)
+	if c.reauthenticateMutation(ctx, metadata, err) {
+		ok, metadata, err = bmc.ResetBMCFromInterfaces(ctx, c.perProviderTimeout(ctx), resetType, c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
 	metadata.RegisterSpanAttributes(c.Auth.Host, span)
 
 	return ok, err
@@ -530,8 +865,13 @@ func (c *Client) ResetBMC(ctx context.Context, resetType string) (ok bool, err e
 func (c *Client) DeactivateSOL(ctx context.Context) (err error) {
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "DeactivateSOL")
 	defer span.End()
+
+	ctx = c.withCallOptions(ctx)
 	metadata, err := bmc.DeactivateSOLFromInterfaces(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
-	c.setMetadata(metadata)
+	if c.reauthenticateMutation(ctx, metadata, err) {
+		metadata, err = bmc.DeactivateSOLFromInterfaces(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
 	return err
 }
 
@@ -540,8 +880,13 @@ func (c *Client) Inventory(ctx context.Context) (device *common.Device, err erro
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "Inventory")
 	defer span.End()
 
+	ctx = c.withCallOptions(ctx)
+
 	device, metadata, err := bmc.GetInventoryFromInterfaces(ctx, c.registry().GetDriverInterfaces())
-	c.setMetadata(metadata)
+	if c.reauthenticate(ctx, metadata, err) {
+		device, metadata, err = bmc.GetInventoryFromInterfaces(ctx, c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
 	return device, err
 }
 
@@ -549,8 +894,13 @@ func (c *Client) GetBiosConfiguration(ctx context.Context) (biosConfig map[strin
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "GetBiosConfiguration")
 	defer span.End()
 
+	ctx = c.withCallOptions(ctx)
+
 	biosConfig, metadata, err := bmc.GetBiosConfigurationInterfaces(ctx, c.registry().GetDriverInterfaces())
-	c.setMetadata(metadata)
+	if c.reauthenticate(ctx, metadata, err) {
+		biosConfig, metadata, err = bmc.GetBiosConfigurationInterfaces(ctx, c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
 	metadata.RegisterSpanAttributes(c.Auth.Host, span)
 
 	return biosConfig, err
@@ -560,8 +910,13 @@ func (c *Client) SetBiosConfiguration(ctx context.Context, biosConfig map[string
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "SetBiosConfiguration")
 	defer span.End()
 
+	ctx = c.withCallOptions(ctx)
+
 	metadata, err := bmc.SetBiosConfigurationInterfaces(ctx, c.registry().GetDriverInterfaces(), biosConfig)
-	c.setMetadata(metadata)
+	if c.reauthenticateMutation(ctx, metadata, err) {
+		metadata, err = bmc.SetBiosConfigurationInterfaces(ctx, c.registry().GetDriverInterfaces(), biosConfig)
+	}
+	c.setMetadata(ctx, metadata)
 	metadata.RegisterSpanAttributes(c.Auth.Host, span)
 
 	return err
@@ -571,8 +926,13 @@ func (c *Client) SetBiosConfigurationFromFile(ctx context.Context, cfg string) (
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "SetBiosConfigurationFromFile")
 	defer span.End()
 
+	ctx = c.withCallOptions(ctx)
+
 	metadata, err := bmc.SetBiosConfigurationFromFileInterfaces(ctx, c.registry().GetDriverInterfaces(), cfg)
-	c.setMetadata(metadata)
+	if c.reauthenticateMutation(ctx, metadata, err) {
+		metadata, err = bmc.SetBiosConfigurationFromFileInterfaces(ctx, c.registry().GetDriverInterfaces(), cfg)
+	}
+	c.setMetadata(ctx, metadata)
 	metadata.RegisterSpanAttributes(c.Auth.Host, span)
 
 	return err
@@ -582,8 +942,13 @@ func (c *Client) ResetBiosConfiguration(ctx context.Context) (err error) {
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "ResetBiosConfiguration")
 	defer span.End()
 
+	ctx = c.withCallOptions(ctx)
+
 	metadata, err := bmc.ResetBiosConfigurationInterfaces(ctx, c.registry().GetDriverInterfaces())
-	c.setMetadata(metadata)
+	if c.reauthenticateMutation(ctx, metadata, err) {
+		metadata, err = bmc.ResetBiosConfigurationInterfaces(ctx, c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
 	metadata.RegisterSpanAttributes(c.Auth.Host, span)
 
 	return err
@@ -594,8 +959,10 @@ func (c *Client) FirmwareInstall(ctx context.Context, component string, operatio
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "FirmwareInstall")
 	defer span.End()
 
+	ctx = c.withCallOptions(ctx)
+
 	taskID, metadata, err := bmc.FirmwareInstallFromInterfaces(ctx, component, operationApplyTime, forceInstall, reader, c.registry().GetDriverInterfaces())
-	c.setMetadata(metadata)
+	c.setMetadata(ctx, metadata)
 	metadata.RegisterSpanAttributes(c.Auth.Host, span)
 
 	return taskID, err
@@ -608,8 +975,13 @@ func (c *Client) FirmwareInstallStatus(ctx context.Context, installVersion, comp
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "FirmwareInstallStatus")
 	defer span.End()
 
+	ctx = c.withCallOptions(ctx)
+
 	status, metadata, err := bmc.FirmwareInstallStatusFromInterfaces(ctx, installVersion, component, taskID, c.registry().GetDriverInterfaces())
-	c.setMetadata(metadata)
+	if c.reauthenticate(ctx, metadata, err) {
+		status, metadata, err = bmc.FirmwareInstallStatusFromInterfaces(ctx, installVersion, component, taskID, c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
 	metadata.RegisterSpanAttributes(c.Auth.Host, span)
 
 	return status, err
@@ -621,8 +993,13 @@ func (c *Client) PostCode(ctx context.Context) (status string, code int, err err
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "PostCode")
 	defer span.End()
 
+	ctx = c.withCallOptions(ctx)
+
 	status, code, metadata, err := bmc.GetPostCodeInterfaces(ctx, c.registry().GetDriverInterfaces())
-	c.setMetadata(metadata)
+	if c.reauthenticate(ctx, metadata, err) {
+		status, code, metadata, err = bmc.GetPostCodeInterfaces(ctx, c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
 	metadata.RegisterSpanAttributes(c.Auth.Host, span)
 
 	return status, code, err
@@ -632,8 +1009,13 @@ func (c *Client) Screenshot(ctx context.Context) (image []byte, fileType string,
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "Screenshot")
 	defer span.End()
 
+	ctx = c.withCallOptions(ctx)
+
 	image, fileType, metadata, err := bmc.ScreenshotFromInterfaces(ctx, c.registry().GetDriverInterfaces())
-	c.setMetadata(metadata)
+	if c.reauthenticate(ctx, metadata, err) {
+		image, fileType, metadata, err = bmc.ScreenshotFromInterfaces(ctx, c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
 	metadata.RegisterSpanAttributes(c.Auth.Host, span)
 
 	return image, fileType, err
@@ -643,8 +1025,13 @@ func (c *Client) ClearSystemEventLog(ctx context.Context) (err error) {
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "ClearSystemEventLog")
 	defer span.End()
 
+	ctx = c.withCallOptions(ctx)
+
 	metadata, err := bmc.ClearSystemEventLogFromInterfaces(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
-	c.setMetadata(metadata)
+	if c.reauthenticateMutation(ctx, metadata, err) {
+		metadata, err = bmc.ClearSystemEventLogFromInterfaces(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
 	metadata.RegisterSpanAttributes(c.Auth.Host, span)
 
 	return err
@@ -654,8 +1041,10 @@ func (c *Client) MountFloppyImage(ctx context.Context, image io.Reader) (err err
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "MountFloppyImage")
 	defer span.End()
 
+	ctx = c.withCallOptions(ctx)
+
 	metadata, err := bmc.MountFloppyImageFromInterfaces(ctx, image, c.registry().GetDriverInterfaces())
-	c.setMetadata(metadata)
+	c.setMetadata(ctx, metadata)
 	metadata.RegisterSpanAttributes(c.Auth.Host, span)
 
 	return err
@@ -665,8 +1054,13 @@ func (c *Client) UnmountFloppyImage(ctx context.Context) (err error) {
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "UnmountFloppyImage")
 	defer span.End()
 
+	ctx = c.withCallOptions(ctx)
+
 	metadata, err := bmc.UnmountFloppyImageFromInterfaces(ctx, c.registry().GetDriverInterfaces())
-	c.setMetadata(metadata)
+	if c.reauthenticateMutation(ctx, metadata, err) {
+		metadata, err = bmc.UnmountFloppyImageFromInterfaces(ctx, c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
 	metadata.RegisterSpanAttributes(c.Auth.Host, span)
 
 	return err
@@ -677,8 +1071,13 @@ func (c *Client) FirmwareInstallSteps(ctx context.Context, component string) (ac
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "FirmwareInstallSteps")
 	defer span.End()
 
+	ctx = c.withCallOptions(ctx)
+
 	status, metadata, err := bmc.FirmwareInstallStepsFromInterfaces(ctx, component, c.registry().GetDriverInterfaces())
-	c.setMetadata(metadata)
+	if c.reauthenticate(ctx, metadata, err) {
+		status, metadata, err = bmc.FirmwareInstallStepsFromInterfaces(ctx, component, c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
 	metadata.RegisterSpanAttributes(c.Auth.Host, span)
 
 	return status, err
@@ -689,8 +1088,10 @@ func (c *Client) FirmwareUpload(ctx context.Context, component string, file *os.
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "FirmwareUpload")
 	defer span.End()
 
-	uploadVerifyTaskID, metadata, err := bmc.FirmwareUploadFromInterfaces(ctx, component, file, c.Registry.GetDriverInterfaces())
-	c.setMetadata(metadata)
+	ctx = c.withCallOptions(ctx)
+
+	uploadVerifyTaskID, metadata, err := bmc.FirmwareUploadFromInterfaces(ctx, component, file, c.registry().GetDriverInterfaces())
+	c.setMetadata(ctx, metadata)
 	metadata.RegisterSpanAttributes(c.Auth.Host, span)
 
 	return uploadVerifyTaskID, err
@@ -701,8 +1102,13 @@ func (c *Client) FirmwareTaskStatus(ctx context.Context, kind constants.Firmware
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "FirmwareTaskStatus")
 	defer span.End()
 
+	ctx = c.withCallOptions(ctx)
+
 	state, status, metadata, err := bmc.FirmwareTaskStatusFromInterfaces(ctx, kind, component, taskID, installVersion, c.registry().GetDriverInterfaces())
-	c.setMetadata(metadata)
+	if c.reauthenticate(ctx, metadata, err) {
+		state, status, metadata, err = bmc.FirmwareTaskStatusFromInterfaces(ctx, kind, component, taskID, installVersion, c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
 	metadata.RegisterSpanAttributes(c.Auth.Host, span)
 
 	return state, status, err
@@ -713,8 +1119,13 @@ func (c *Client) FirmwareInstallUploaded(ctx context.Context, component, uploadV
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "FirmwareInstallUploaded")
 	defer span.End()
 
+	ctx = c.withCallOptions(ctx)
+
 	installTaskID, metadata, err := bmc.FirmwareInstallerUploadedFromInterfaces(ctx, component, uploadVerifyTaskID, c.registry().GetDriverInterfaces())
-	c.setMetadata(metadata)
+	if c.reauthenticateMutation(ctx, metadata, err) {
+		installTaskID, metadata, err = bmc.FirmwareInstallerUploadedFromInterfaces(ctx, component, uploadVerifyTaskID, c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
 	metadata.RegisterSpanAttributes(c.Auth.Host, span)
 
 	return installTaskID, err
@@ -724,20 +1135,28 @@ func (c *Client) FirmwareInstallUploadAndInitiate(ctx context.Context, component
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "FirmwareInstallUploadAndInitiate")
 	defer span.End()
 
+	ctx = c.withCallOptions(ctx)
+
 	taskID, metadata, err := bmc.FirmwareInstallUploadAndInitiateFromInterfaces(ctx, component, file, c.registry().GetDriverInterfaces())
-	c.setMetadata(metadata)
+	c.setMetadata(ctx, metadata)
 	metadata.RegisterSpanAttributes(c.Auth.Host, span)
 
 	return taskID, err
 }
 
-// GetSystemEventLog queries for the SEL and returns the entries in an opinionated format.
+// GetSystemEventLog queries for the SEL and returns the entries in an opinionated format,
+// kept for compatibility, GetSystemEventLogEntries returns the typed entries.
 func (c *Client) GetSystemEventLog(ctx context.Context) (entries bmc.SystemEventLogEntries, err error) {
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "GetSystemEventLog")
 	defer span.End()
 
+	ctx = c.withCallOptions(ctx)
+
 	entries, metadata, err := bmc.GetSystemEventLogFromInterfaces(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
-	c.setMetadata(metadata)
+	if c.reauthenticate(ctx, metadata, err) {
+		entries, metadata, err = bmc.GetSystemEventLogFromInterfaces(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
 	return entries, err
 }
 
@@ -746,8 +1165,13 @@ func (c *Client) GetSystemEventLogRaw(ctx context.Context) (eventlog string, err
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "GetSystemEventLogRaw")
 	defer span.End()
 
+	ctx = c.withCallOptions(ctx)
+
 	eventlog, metadata, err := bmc.GetSystemEventLogRawFromInterfaces(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
-	c.setMetadata(metadata)
+	if c.reauthenticate(ctx, metadata, err) {
+		eventlog, metadata, err = bmc.GetSystemEventLogRawFromInterfaces(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
 	return eventlog, err
 }
 
@@ -756,8 +1180,224 @@ func (c *Client) SendNMI(ctx context.Context) error {
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "SendNMI")
 	defer span.End()
 
+	ctx = c.withCallOptions(ctx)
+
 	metadata, err := bmc.SendNMIFromInterface(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
-	c.setMetadata(metadata)
+	if c.reauthenticateMutation(ctx, metadata, err) {
+		metadata, err = bmc.SendNMIFromInterface(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
+
+	return err
+}
+
+// PowerReading returns the power consumption of the machine in watts, along with the power limit when one is configured.
+func (c *Client) PowerReading(ctx context.Context) (reading bmc.PowerReading, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "PowerReading")
+	defer span.End()
+
+	ctx = c.withCallOptions(ctx)
+
+	reading, metadata, err := bmc.PowerReadFromInterfaces(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
+	if c.reauthenticate(ctx, metadata, err) {
+		reading, metadata, err = bmc.PowerReadFromInterfaces(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return reading, err
+}
+
+// SetPowerCap sets and enforces the power limit of the machine in watts, a limit of zero removes the power limit.
+func (c *Client) SetPowerCap(ctx context.Context, watts int) (err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "SetPowerCap")
+	defer span.End()
+
+	ctx = c.withCallOptions(ctx)
+
+	metadata, err := bmc.PowerCapSetFromInterfaces(ctx, c.perProviderTimeout(ctx), watts, c.registry().GetDriverInterfaces())
+	if c.reauthenticateMutation(ctx, metadata, err) {
+		metadata, err = bmc.PowerCapSetFromInterfaces(ctx, c.perProviderTimeout(ctx), watts, c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return err
+}
+
+// GetPowerRestorePolicy returns the power state the machine returns to when AC power is restored after a power loss.
+func (c *Client) GetPowerRestorePolicy(ctx context.Context) (policy bmc.PowerRestorePolicy, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "GetPowerRestorePolicy")
+	defer span.End()
+
+	ctx = c.withCallOptions(ctx)
+
+	policy, metadata, err := bmc.GetPowerRestorePolicyFromInterfaces(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
+	if c.reauthenticate(ctx, metadata, err) {
+		policy, metadata, err = bmc.GetPowerRestorePolicyFromInterfaces(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return policy, err
+}
+
+// SetPowerRestorePolicy sets the power state the machine returns to when AC power is restored after a power loss.
+//
+// Providers that store the policy in the BIOS settings, like the dell provider, apply it on the next system reset.
+func (c *Client) SetPowerRestorePolicy(ctx context.Context, policy bmc.PowerRestorePolicy) (err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "SetPowerRestorePolicy")
+	defer span.End()
+
+	ctx = c.withCallOptions(ctx)
+
+	metadata, err := bmc.SetPowerRestorePolicyFromInterfaces(ctx, c.perProviderTimeout(ctx), policy, c.registry().GetDriverInterfaces())
+	if c.reauthenticateMutation(ctx, metadata, err) {
+		metadata, err = bmc.SetPowerRestorePolicyFromInterfaces(ctx, c.perProviderTimeout(ctx), policy, c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return err
+}
+
+// GetIdentify returns the state of the chassis identify LED.
+func (c *Client) GetIdentify(ctx context.Context) (state bmc.IdentifyState, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "GetIdentify")
+	defer span.End()
+
+	ctx = c.withCallOptions(ctx)
+
+	state, metadata, err := bmc.GetIdentifyFromInterfaces(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
+	if c.reauthenticate(ctx, metadata, err) {
+		state, metadata, err = bmc.GetIdentifyFromInterfaces(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return state, err
+}
+
+// SetIdentify sets the state of the chassis identify LED used to locate the machine,
+// the duration applies to bmc.IdentifyBlink and turns the LED off once elapsed, a zero duration keeps it blinking.
+//
+// Providers that can't turn the LED off after a duration, like the Redfish providers, fail for a non zero duration
+// and the next provider is tried.
+func (c *Client) SetIdentify(ctx context.Context, state bmc.IdentifyState, duration time.Duration) (err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "SetIdentify")
+	defer span.End()
+
+	ctx = c.withCallOptions(ctx)
+
+	metadata, err := bmc.SetIdentifyFromInterfaces(ctx, c.perProviderTimeout(ctx), state, duration, c.registry().GetDriverInterfaces())
+	if c.reauthenticateMutation(ctx, metadata, err) {
+		metadata, err = bmc.SetIdentifyFromInterfaces(ctx, c.perProviderTimeout(ctx), state, duration, c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return err
+}
+
+// LastRestart returns the cause of the last restart of the machine, along with the restart time
+// and power on hours when reported by the provider.
+func (c *Client) LastRestart(ctx context.Context) (info bmc.RestartInfo, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "LastRestart")
+	defer span.End()
+
+	ctx = c.withCallOptions(ctx)
+
+	info, metadata, err := bmc.LastRestartFromInterfaces(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
+	if c.reauthenticate(ctx, metadata, err) {
+		info, metadata, err = bmc.LastRestartFromInterfaces(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return info, err
+}
+
+// Sensors returns the temperature, fan and voltage sensor readings of the machine.
+func (c *Client) Sensors(ctx context.Context) (readings []bmc.SensorReading, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "Sensors")
+	defer span.End()
+
+	ctx = c.withCallOptions(ctx)
+
+	readings, metadata, err := bmc.ReadSensorsFromInterfaces(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
+	if c.reauthenticate(ctx, metadata, err) {
+		readings, metadata, err = bmc.ReadSensorsFromInterfaces(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return readings, err
+}
+
+// GetSystemEventLogEntries queries for the SEL and returns the typed entries.
+func (c *Client) GetSystemEventLogEntries(ctx context.Context) (entries []bmc.SystemEventLogEntry, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "GetSystemEventLogEntries")
+	defer span.End()
+
+	ctx = c.withCallOptions(ctx)
+
+	entries, metadata, err := bmc.GetSystemEventLogEntriesFromInterfaces(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
+	if c.reauthenticate(ctx, metadata, err) {
+		entries, metadata, err = bmc.GetSystemEventLogEntriesFromInterfaces(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return entries, err
+}
+
+// LogServices returns the log services of the BMC, like the SEL, the Dell Lifecycle log or the OpenBMC EventLog.
+func (c *Client) LogServices(ctx context.Context) (services []bmc.LogServiceInfo, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "LogServices")
+	defer span.End()
+
+	ctx = c.withCallOptions(ctx)
+
+	services, metadata, err := bmc.LogServicesFromInterfaces(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
+	if c.reauthenticate(ctx, metadata, err) {
+		services, metadata, err = bmc.LogServicesFromInterfaces(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return services, err
+}
+
+// GetLogServiceEntries returns the entries of the log service with the given Path, or ID when only one log service has that ID.
+func (c *Client) GetLogServiceEntries(ctx context.Context, id string) (entries []bmc.SystemEventLogEntry, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "GetLogServiceEntries")
+	defer span.End()
+
+	ctx = c.withCallOptions(ctx)
+
+	entries, metadata, err := bmc.GetLogServiceEntriesFromInterfaces(ctx, c.perProviderTimeout(ctx), id, c.registry().GetDriverInterfaces())
+	if c.reauthenticate(ctx, metadata, err) {
+		entries, metadata, err = bmc.GetLogServiceEntriesFromInterfaces(ctx, c.perProviderTimeout(ctx), id, c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return entries, err
+}
+
+// ClearLogService clears the log service with the given Path, or ID when only one log service has that ID.
+func (c *Client) ClearLogService(ctx context.Context, id string) (err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "ClearLogService")
+	defer span.End()
+
+	ctx = c.withCallOptions(ctx)
+
+	metadata, err := bmc.ClearLogServiceFromInterfaces(ctx, c.perProviderTimeout(ctx), id, c.registry().GetDriverInterfaces())
+	if c.reauthenticateMutation(ctx, metadata, err) {
+		metadata, err = bmc.ClearLogServiceFromInterfaces(ctx, c.perProviderTimeout(ctx), id, c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
 
 	return err
 }
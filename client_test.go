@@ -2,12 +2,20 @@ package bmclib
 
 import (
 	"context"
+	"errors"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/jacobweinstock/registrar"
+	"github.com/metal-toolbox/bmclib/bmc"
+	"github.com/metal-toolbox/bmclib/credentials"
+	bmclibErrs "github.com/metal-toolbox/bmclib/errors"
 	"github.com/metal-toolbox/bmclib/logging"
+	"github.com/metal-toolbox/bmclib/providers"
+	"github.com/metal-toolbox/bmclib/providers/asrockrack"
+	"github.com/metal-toolbox/bmclib/providers/ipmitool"
 	"gopkg.in/go-playground/assert.v1"
 )
 
@@ -235,3 +243,796 @@ func TestOpenFiltered(t *testing.T) {
 		t.Errorf("diff: %s", diff)
 	}
 }
+
+func TestWithCallMetadata(t *testing.T) {
+	registry := registrar.NewRegistry()
+	registry.Register("tester1", "tester1", nil, nil, &testProvider{PName: "tester1", Powerstate: "on"})
+	registry.Register("tester2", "tester2", nil, nil, &testProvider{PName: "tester2", Powerstate: "off", BootdeviceOK: true})
+	cl := NewClient("", "", "", WithRegistry(registry))
+
+	stateCtx, stateMetadata := WithCallMetadata(context.Background())
+	bootCtx, bootMetadata := WithCallMetadata(context.Background())
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		if _, err := cl.GetPowerState(stateCtx); err != nil {
+			t.Error(err)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		if _, err := cl.SetBootDevice(bootCtx, "pxe", false, true); err != nil {
+			t.Error(err)
+		}
+	}()
+	wg.Wait()
+
+	assert.Equal(t, stateMetadata.Metadata().SuccessfulProvider, "tester1")
+	assert.Equal(t, stateMetadata.Metadata().ProvidersAttempted, []string{"tester1"})
+	assert.Equal(t, len(stateMetadata.All()), 1)
+	if _, ok := stateMetadata.Metadata().ProviderDurations["tester1"]; !ok {
+		t.Error("expected a duration for provider tester1")
+	}
+
+	assert.Equal(t, bootMetadata.Metadata().SuccessfulProvider, "tester2")
+	assert.Equal(t, bootMetadata.Metadata().ProvidersAttempted, []string{"tester1", "tester2"})
+}
+
+func TestScopedClientViews(t *testing.T) {
+	registry := registrar.NewRegistry()
+	registry.Register("tester1", "tester1", nil, nil, &testProvider{PName: "tester1", Powerstate: "on"})
+	registry.Register("tester2", "tester2", nil, nil, &testProvider{PName: "tester2", Powerstate: "off"})
+	registry.Register("tester3", "tester3", nil, nil, &testProvider{PName: "tester3", Powerstate: "on"})
+	cl := NewClient("", "", "", WithRegistry(registry))
+
+	var wg sync.WaitGroup
+	for _, name := range []string{"tester1", "tester2", "tester3"} {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+
+			view := cl.For(name)
+			for i := 0; i < 10; i++ {
+				ctx, md := WithCallMetadata(context.Background())
+				if _, err := view.GetPowerState(ctx); err != nil {
+					t.Error(err)
+					return
+				}
+
+				if md.Metadata().SuccessfulProvider != name {
+					t.Errorf("expected provider %s, got %s", name, md.Metadata().SuccessfulProvider)
+				}
+			}
+		}(name)
+	}
+	wg.Wait()
+
+	// the view scopes are combined and the Client is not modified.
+	view := cl.PreferProvider("tester3").Using("tester2")
+	assert.Equal(t, registryNames(view.registry().Drivers), []string{"tester2"})
+	assert.Equal(t, registryNames(cl.registry().Drivers), []string{"tester1", "tester2", "tester3"})
+
+	// metadata of calls made with a view is available from the Client.
+	if _, err := cl.PreferProvider("tester2").GetPowerState(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, cl.GetMetadata().SuccessfulProvider, "tester2")
+}
+
+type capabilityTestProvider struct {
+	testProvider
+	caps bmc.Capabilities
+}
+
+func (c *capabilityTestProvider) ProbeCapabilities(_ context.Context) (bmc.Capabilities, error) {
+	return c.caps, nil
+}
+
+func TestCapabilities(t *testing.T) {
+	features := registrar.Features{"virtualmedia"}
+	registry := registrar.NewRegistry()
+	registry.Register("tester1", "tester1", features, nil, &capabilityTestProvider{
+		testProvider: testProvider{PName: "tester1"},
+		caps:         bmc.Capabilities{Unsupported: features},
+	})
+	registry.Register("tester2", "tester2", features, nil, &testProvider{PName: "tester2"})
+	cl := NewClient("", "", "", WithRegistry(registry))
+
+	assert.Equal(t, registryNames(cl.Supports("virtualmedia").registry().Drivers), []string{"tester1", "tester2"})
+
+	report, err := cl.Capabilities(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]bmc.Capabilities{
+		"tester1": {Unsupported: features, Probed: true},
+		"tester2": {Features: features},
+	}
+	if diff := cmp.Diff(report, want); diff != "" {
+		t.Errorf("diff: %s", diff)
+	}
+
+	assert.Equal(t, registryNames(cl.Supports("virtualmedia").registry().Drivers), []string{"tester2"})
+}
+
+func TestWithProviders(t *testing.T) {
+	var got providers.FactoryConfig
+	factory := providers.NewFactory("external", "tester", registrar.Features{providers.FeaturePowerState}, func(config providers.FactoryConfig) (interface{}, error) {
+		got = config
+		return &testProvider{PName: "external", Powerstate: "on"}, nil
+	})
+
+	cl := NewClient("127.0.0.1", "ADMIN", "ADMIN", WithProviders(factory), WithoutBuiltinProviders(ipmitool.ProviderName, asrockrack.ProviderName))
+	names := registryNames(cl.Registry.Drivers)
+	assert.Equal(t, names[len(names)-1], "external")
+	assert.Equal(t, got.Host, "127.0.0.1")
+	assert.Equal(t, got.User, "ADMIN")
+	assert.Equal(t, got.HTTPClient != nil, true)
+	for _, name := range names {
+		if name == ipmitool.ProviderName || name == asrockrack.ProviderName {
+			t.Errorf("disabled built-in provider registered: %s", name)
+		}
+	}
+
+	cl = NewClient("127.0.0.1", "ADMIN", "ADMIN", WithProviders(factory), WithoutBuiltinProviders())
+	assert.Equal(t, registryNames(cl.Registry.Drivers), []string{"external"})
+
+	state, err := cl.GetPowerState(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, state, "on")
+}
+
+type authTestProvider struct {
+	testProvider
+	pass string
+	want string
+}
+
+func (a *authTestProvider) Open(ctx context.Context) error {
+	if a.pass != a.want {
+		return bmclibErrs.ErrLoginFailed
+	}
+
+	return nil
+}
+
+type rotatingCredentials struct {
+	calls []credentials.Credentials
+}
+
+func (r *rotatingCredentials) Credentials(ctx context.Context, host string) (credentials.Credentials, error) {
+	creds := r.calls[0]
+	if len(r.calls) > 1 {
+		r.calls = r.calls[1:]
+	}
+
+	return creds, nil
+}
+
+func TestWithCredentialProvider(t *testing.T) {
+	factory := providers.NewFactory("tester", "tester", nil, func(config providers.FactoryConfig) (interface{}, error) {
+		return &authTestProvider{testProvider: testProvider{PName: "tester"}, pass: config.Pass, want: "rotated"}, nil
+	})
+
+	tests := map[string]struct {
+		calls   []credentials.Credentials
+		wantErr bool
+	}{
+		"credentials at open":            {calls: []credentials.Credentials{{User: "admin", Pass: "rotated"}}},
+		"credentials rotated after open": {calls: []credentials.Credentials{{User: "admin", Pass: "stale"}, {User: "admin", Pass: "rotated"}}},
+		"credentials unchanged":          {calls: []credentials.Credentials{{User: "admin", Pass: "stale"}}, wantErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			cl := NewClient("127.0.0.1", "", "", WithProviders(factory), WithoutBuiltinProviders(), WithCredentialProvider(&rotatingCredentials{calls: tc.calls}))
+			err := cl.Open(context.Background())
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, cl.Auth.Pass, "rotated")
+			assert.Equal(t, cl.GetMetadata().SuccessfulOpenConns, []string{"tester"})
+		})
+	}
+}
+
+func TestOpenRebuildsRegisteredProviders(t *testing.T) {
+	fake := &fakeBMC{pass: "one"}
+	factory := func(name string) providers.Factory {
+		return providers.NewFactory(name, name, nil, func(config providers.FactoryConfig) (interface{}, error) {
+			return &userTestProvider{testProvider: testProvider{PName: name}, bmc: fake, pass: config.Pass}, nil
+		})
+	}
+
+	creds := &rotatingCredentials{calls: []credentials.Credentials{{User: "admin", Pass: "one"}, {User: "admin", Pass: "two"}}}
+	cl := NewClient("127.0.0.1", "admin", "", WithProviders(factory("tester1"), factory("tester2")), WithoutBuiltinProviders(), WithCredentialProvider(creds))
+	cl.Registry.Drivers = cl.Registry.For("tester1")
+
+	if err := cl.Open(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	// the credentials are rotated, the second Open replaces the provider opened by the first one.
+	opened := cl.Registry.Drivers[0].DriverInterface.(*userTestProvider)
+	fake.pass = "two"
+	if err := cl.Open(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, opened.closed, true)
+	assert.Equal(t, registryNames(cl.Registry.Drivers), []string{"tester1"})
+	assert.Equal(t, cl.Registry.Drivers[0].DriverInterface.(*userTestProvider).pass, "two")
+}
+
+// sessionTestProvider fails method calls once the BMC password no longer matches the one it was opened with.
+type sessionTestProvider struct {
+	userTestProvider
+}
+
+func (s *sessionTestProvider) PowerStateGet(ctx context.Context) (string, error) {
+	s.bmc.mu.Lock()
+	defer s.bmc.mu.Unlock()
+
+	if s.pass != s.bmc.pass {
+		return "", bmclibErrs.ErrNotAuthenticated
+	}
+
+	return "on", nil
+}
+
+func TestMethodCallReauthenticates(t *testing.T) {
+	fake := &fakeBMC{pass: "one"}
+	factory := func(name string) providers.Factory {
+		return providers.NewFactory(name, name, nil, func(config providers.FactoryConfig) (interface{}, error) {
+			return &sessionTestProvider{userTestProvider{testProvider: testProvider{PName: name}, bmc: fake, pass: config.Pass}}, nil
+		})
+	}
+
+	creds := &rotatingCredentials{calls: []credentials.Credentials{{User: "admin", Pass: "one"}, {User: "admin", Pass: "two"}}}
+	cl := NewClient("127.0.0.1", "admin", "", WithProviders(factory("tester1"), factory("tester2")), WithoutBuiltinProviders(), WithCredentialProvider(creds))
+	if err := cl.Open(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	// the password is rotated outside of the Client, the call made with a view is retried with the new credentials.
+	fake.pass = "two"
+	state, err := cl.For("tester2").GetPowerState(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, state, "on")
+	assert.Equal(t, cl.Auth.Pass, "two")
+	assert.Equal(t, registryNames(cl.Registry.Drivers), []string{"tester1", "tester2"})
+	assert.Equal(t, cl.GetMetadata().SuccessfulProvider, "tester2")
+
+	// the credentials are unchanged, the authentication failure is returned.
+	fake.pass = "three"
+	if _, err := cl.GetPowerState(context.Background()); err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+// powerSetTestProvider fails to set the power state with err, or when its session is not authenticated.
+type powerSetTestProvider struct {
+	sessionTestProvider
+	err   error
+	calls int
+}
+
+func (p *powerSetTestProvider) PowerSet(ctx context.Context, state string) (bool, error) {
+	p.calls++
+	if p.err != nil {
+		return false, p.err
+	}
+
+	if _, err := p.PowerStateGet(ctx); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func TestMutationReauthenticates(t *testing.T) {
+	tests := map[string]struct {
+		otherErr  error
+		wantCalls int
+		wantPass  string
+		wantErr   bool
+	}{
+		"every provider failed to authenticate": {otherErr: bmclibErrs.ErrNotAuthenticated, wantCalls: 2, wantPass: "two"},
+		"a provider failed otherwise":           {otherErr: errors.New("power set failed"), wantCalls: 1, wantPass: "one", wantErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			fake := &fakeBMC{pass: "one"}
+			var built []*powerSetTestProvider
+			factory := func(name string, err error) providers.Factory {
+				return providers.NewFactory(name, name, nil, func(config providers.FactoryConfig) (interface{}, error) {
+					p := &powerSetTestProvider{sessionTestProvider: sessionTestProvider{userTestProvider{testProvider: testProvider{PName: name}, bmc: fake, pass: config.Pass}}, err: err}
+					built = append(built, p)
+					return p, nil
+				})
+			}
+
+			creds := &rotatingCredentials{calls: []credentials.Credentials{{User: "admin", Pass: "one"}, {User: "admin", Pass: "two"}}}
+			cl := NewClient("127.0.0.1", "admin", "", WithProviders(factory("tester1", nil), factory("tester2", tc.otherErr)), WithoutBuiltinProviders(), WithCredentialProvider(creds))
+			if err := cl.Open(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+
+			// the password is rotated outside of the Client, the power action is made again only when
+			// no provider could have applied it.
+			fake.pass = "two"
+			_, err := cl.SetPowerState(context.Background(), "cycle")
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var calls int
+			for _, p := range built {
+				if p.PName == "tester1" {
+					calls += p.calls
+				}
+			}
+
+			assert.Equal(t, calls, tc.wantCalls)
+			assert.Equal(t, cl.Auth.Pass, tc.wantPass)
+		})
+	}
+}
+
+func TestWithFallbackCredentials(t *testing.T) {
+	factory := func(name, want string) providers.Factory {
+		return providers.NewFactory(name, name, nil, func(config providers.FactoryConfig) (interface{}, error) {
+			return &authTestProvider{testProvider: testProvider{PName: name}, pass: config.Pass, want: want}, nil
+		})
+	}
+
+	fallback := []credentials.Credentials{{User: "ADMIN", Pass: "unit"}, {User: "ADMIN", Pass: "ADMIN"}}
+
+	tests := map[string]struct {
+		factories []providers.Factory
+		wantCreds map[string]int
+		wantNames []string
+		wantPass  string
+		wantErr   bool
+	}{
+		"mixed credentials": {
+			factories: []providers.Factory{factory("tester1", "ADMIN"), factory("tester2", "ours"), factory("tester3", "unit")},
+			wantCreds: map[string]int{"tester1": 2, "tester2": 0, "tester3": 1},
+			wantNames: []string{"tester1", "tester2", "tester3"},
+			wantPass:  "ours",
+		},
+		"fallback credentials only": {
+			factories: []providers.Factory{factory("tester1", "unit"), factory("tester2", "ADMIN")},
+			wantCreds: map[string]int{"tester1": 1, "tester2": 2},
+			wantNames: []string{"tester1", "tester2"},
+			wantPass:  "unit",
+		},
+		"no credentials match": {
+			factories: []providers.Factory{factory("tester1", "other")},
+			wantErr:   true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			cl := NewClient("127.0.0.1", "admin", "ours", WithProviders(tc.factories...), WithoutBuiltinProviders(), WithFallbackCredentials(fallback...))
+			err := cl.Open(context.Background())
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, cl.GetMetadata().ProviderCredentials, tc.wantCreds)
+			assert.Equal(t, registryNames(cl.Registry.Drivers), tc.wantNames)
+			assert.Equal(t, cl.Auth.Pass, tc.wantPass)
+		})
+	}
+}
+
+// fakeBMC holds the password of the account the userTestProvider authenticates as,
+// stale holds the password a provider keeps authenticating with after an update, keyed by provider name.
+type fakeBMC struct {
+	mu            sync.Mutex
+	pass          string
+	stale         map[string]string
+	rejectUpdates bool
+	failRollback  bool
+	updates       int
+}
+
+type userTestProvider struct {
+	testProvider
+	bmc    *fakeBMC
+	pass   string
+	closed bool
+}
+
+func (u *userTestProvider) Open(ctx context.Context) error {
+	u.bmc.mu.Lock()
+	defer u.bmc.mu.Unlock()
+
+	pass := u.bmc.pass
+	if stale, ok := u.bmc.stale[u.Name()]; ok {
+		pass = stale
+	}
+
+	if u.pass != pass {
+		return bmclibErrs.ErrLoginFailed
+	}
+
+	return nil
+}
+
+func (u *userTestProvider) Close(ctx context.Context) error {
+	u.closed = true
+	return nil
+}
+
+func (u *userTestProvider) UserUpdate(ctx context.Context, user, pass, role string) (bool, error) {
+	u.bmc.mu.Lock()
+	defer u.bmc.mu.Unlock()
+
+	u.bmc.updates++
+	if u.bmc.updates > 1 && u.bmc.failRollback {
+		return false, bmclibErrs.ErrUserAccountUpdate
+	}
+
+	if !u.bmc.rejectUpdates {
+		u.bmc.pass = pass
+	}
+
+	return true, nil
+}
+
+func TestRotateCredentials(t *testing.T) {
+	tests := map[string]struct {
+		bmc            *fakeBMC
+		wantPass       string
+		wantBMCPass    string
+		wantUnverified []string
+		wantRolledBack bool
+		wantErr        bool
+	}{
+		"rotated":         {bmc: &fakeBMC{pass: "old"}, wantPass: "new", wantBMCPass: "new"},
+		"rolled back":     {bmc: &fakeBMC{pass: "old", stale: map[string]string{"other": "old"}}, wantPass: "old", wantBMCPass: "old", wantUnverified: []string{"other"}, wantRolledBack: true, wantErr: true},
+		"rollback failed": {bmc: &fakeBMC{pass: "old", stale: map[string]string{"other": "old"}, failRollback: true}, wantPass: "old", wantBMCPass: "new", wantUnverified: []string{"other"}, wantErr: true},
+		"not updated":     {bmc: &fakeBMC{pass: "old", rejectUpdates: true}, wantPass: "old", wantBMCPass: "old", wantUnverified: []string{"tester", "other"}, wantErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			built := map[string][]*userTestProvider{}
+			factory := func(name string) providers.Factory {
+				return providers.NewFactory(name, name, nil, func(config providers.FactoryConfig) (interface{}, error) {
+					p := &userTestProvider{testProvider: testProvider{PName: name}, bmc: tc.bmc, pass: config.Pass}
+					built[name] = append(built[name], p)
+					return p, nil
+				})
+			}
+
+			cl := NewClient("127.0.0.1", "admin", "old", WithProviders(factory("tester"), factory("other")), WithoutBuiltinProviders())
+			if err := cl.Open(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+
+			err := cl.RotateCredentials(context.Background(), "new", "Administrator")
+			assert.Equal(t, cl.Auth.Pass, tc.wantPass)
+			assert.Equal(t, tc.bmc.pass, tc.wantBMCPass)
+			if !tc.wantErr {
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				// the previous connections are closed and every provider is kept with the new credentials.
+				for i, name := range []string{"tester", "other"} {
+					assert.Equal(t, built[name][0].closed, true)
+					assert.Equal(t, cl.Registry.Drivers[i].DriverInterface, built[name][1])
+				}
+
+				return
+			}
+
+			var rotationErr *CredentialRotationError
+			if !errors.As(err, &rotationErr) {
+				t.Fatalf("expected a CredentialRotationError, got: %v", err)
+			}
+
+			assert.Equal(t, rotationErr.Provider, "tester")
+			assert.Equal(t, rotationErr.Unverified, tc.wantUnverified)
+			assert.Equal(t, rotationErr.RolledBack, tc.wantRolledBack)
+			assert.Equal(t, cl.Registry.Drivers[0].DriverInterface, built["tester"][0])
+			assert.Equal(t, cl.Registry.Drivers[1].DriverInterface, built["other"][0])
+		})
+	}
+}
+
+func TestViewsShareCredentialsAndRegistry(t *testing.T) {
+	fake := &fakeBMC{pass: "old"}
+	factory := providers.NewFactory("tester", "tester", nil, func(config providers.FactoryConfig) (interface{}, error) {
+		return &userTestProvider{testProvider: testProvider{PName: "tester", Powerstate: "on"}, bmc: fake, pass: config.Pass}, nil
+	})
+
+	cl := NewClient("127.0.0.1", "admin", "old", WithProviders(factory), WithoutBuiltinProviders())
+	rotating, sibling := cl.For("tester"), cl.PreferProvider("tester")
+
+	// views open, filter and call the providers while the registry is replaced.
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			if err := rotating.Open(context.Background()); err != nil {
+				t.Error(err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			sibling.FilterForCompatible(context.Background())
+		}()
+		go func() {
+			defer wg.Done()
+			_, _ = sibling.GetPowerState(context.Background())
+		}()
+	}
+	wg.Wait()
+
+	// the drivers and credentials are swapped while a sibling view calls the providers
+	// and new views are created from the Client.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 10; i++ {
+			_, _ = sibling.GetPowerState(context.Background())
+			_ = sibling.currentCredentials()
+			_ = cl.PreferProtocol("tester").currentCredentials()
+		}
+	}()
+
+	if err := rotating.RotateCredentials(context.Background(), "new", "Administrator"); err != nil {
+		t.Fatal(err)
+	}
+	<-done
+
+	assert.Equal(t, "new", cl.Auth.Pass)
+	assert.Equal(t, "new", sibling.currentCredentials().Pass)
+	assert.Equal(t, registryNames(sibling.registry().Drivers), []string{"tester"})
+}
+
+// powerSequenceProvider returns the power states in order, the last state is repeated.
+type powerSequenceProvider struct {
+	name   string
+	mu     sync.Mutex
+	states []string
+	action string
+}
+
+func (p *powerSequenceProvider) Name() string {
+	return p.name
+}
+
+func (p *powerSequenceProvider) PowerSet(ctx context.Context, state string) (bool, error) {
+	p.action = state
+	return true, nil
+}
+
+func (p *powerSequenceProvider) PowerStateGet(ctx context.Context) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	state := p.states[0]
+	if len(p.states) > 1 {
+		p.states = p.states[1:]
+	}
+
+	return state, nil
+}
+
+func TestSetPowerStateAndWait(t *testing.T) {
+	tests := map[string]struct {
+		action       bmc.PowerAction
+		opts         PowerWaitOptions
+		states       []string
+		wantStates   []bmc.PowerState
+		wantPolls    int
+		wantTimedOut bool
+	}{
+		"powered off": {
+			action:     bmc.PowerActionOff,
+			states:     []string{"On", "On", "PoweringOff", "Off"},
+			wantStates: []bmc.PowerState{bmc.PowerStateOn, bmc.PowerStatePoweringOff, bmc.PowerStateOff},
+			wantPolls:  4,
+		},
+		"timed out": {
+			action:       bmc.PowerActionSoft,
+			opts:         PowerWaitOptions{Timeout: 50 * time.Millisecond},
+			states:       []string{"On"},
+			wantStates:   []bmc.PowerState{bmc.PowerStateOn},
+			wantTimedOut: true,
+		},
+		"polled through another provider": {
+			action:     bmc.PowerActionCycle,
+			opts:       PowerWaitOptions{Provider: "poller"},
+			states:     []string{"Chassis Power is off", "Chassis Power is on"},
+			wantStates: []bmc.PowerState{bmc.PowerStateOff, bmc.PowerStateOn},
+			wantPolls:  2,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			setter := &powerSequenceProvider{name: "setter", states: tc.states}
+			poller := &powerSequenceProvider{name: "poller", states: []string{"unknown"}}
+			if tc.opts.Provider != "" {
+				setter.states, poller.states = []string{"unknown"}, tc.states
+			}
+
+			registry := registrar.NewRegistry()
+			registry.Register(setter.name, "tester", nil, nil, setter)
+			registry.Register(poller.name, "tester", nil, nil, poller)
+
+			tc.opts.PollInterval = time.Millisecond
+			cl := NewClient("", "", "", WithRegistry(registry))
+			transition, err := cl.SetPowerStateAndWait(context.Background(), tc.action, tc.opts)
+
+			assert.Equal(t, errors.Is(err, bmclibErrs.ErrPowerStateTimeout), tc.wantTimedOut)
+			if !tc.wantTimedOut && err != nil {
+				t.Fatal(err)
+			}
+
+			var states []bmc.PowerState
+			for _, observation := range transition.Observations {
+				states = append(states, observation.State)
+			}
+
+			assert.Equal(t, states, tc.wantStates)
+			assert.Equal(t, transition.Reached, !tc.wantTimedOut)
+			assert.Equal(t, transition.Provider, "setter")
+			assert.Equal(t, setter.action, string(tc.action))
+			if tc.wantPolls > 0 {
+				assert.Equal(t, transition.Polls, tc.wantPolls)
+			}
+		})
+	}
+}
+
+// shutdownTestProvider powers off on soft power off when the OS shuts down.
+type shutdownTestProvider struct {
+	name       string
+	mu         sync.Mutex
+	state      string
+	osShutdown bool
+	actions    []string
+}
+
+func (s *shutdownTestProvider) Name() string {
+	return s.name
+}
+
+func (s *shutdownTestProvider) PowerSet(ctx context.Context, state string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.actions = append(s.actions, state)
+	if state == "off" || (state == "soft" && s.osShutdown) {
+		s.state = "Off"
+	}
+
+	return true, nil
+}
+
+func (s *shutdownTestProvider) PowerStateGet(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.state, nil
+}
+
+func TestSetPowerStateAndWaitContextDone(t *testing.T) {
+	tests := map[string]struct {
+		ctx     func() (context.Context, context.CancelFunc)
+		wantErr error
+	}{
+		"canceled": {
+			ctx: func() (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithCancel(context.Background())
+				time.AfterFunc(20*time.Millisecond, cancel)
+				return ctx, cancel
+			},
+			wantErr: context.Canceled,
+		},
+		"deadline exceeded": {
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.Background(), 20*time.Millisecond)
+			},
+			wantErr: context.DeadlineExceeded,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			provider := &powerSequenceProvider{name: "tester", states: []string{"On"}}
+			registry := registrar.NewRegistry()
+			registry.Register(provider.name, "tester", nil, nil, provider)
+
+			ctx, cancel := tc.ctx()
+			defer cancel()
+
+			cl := NewClient("", "", "", WithRegistry(registry))
+			transition, err := cl.SetPowerStateAndWait(ctx, bmc.PowerActionOff, PowerWaitOptions{Timeout: time.Minute, PollInterval: time.Millisecond})
+
+			// the caller gave up before the wait timeout, it is not reported as a power state timeout.
+			assert.Equal(t, errors.Is(err, tc.wantErr), true)
+			assert.Equal(t, errors.Is(err, bmclibErrs.ErrPowerStateTimeout), false)
+			assert.Equal(t, transition.Reached, false)
+		})
+	}
+}
+
+func TestGracefulPowerOff(t *testing.T) {
+	tests := map[string]struct {
+		osShutdown    bool
+		escalateWith  string
+		wantEscalated bool
+		wantActions   []string
+	}{
+		"os shut down":             {osShutdown: true, wantActions: []string{"soft"}},
+		"escalated":                {wantEscalated: true, wantActions: []string{"soft", "off"}},
+		"escalated via a provider": {escalateWith: "tester2", wantEscalated: true, wantActions: []string{"soft"}},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			first := &shutdownTestProvider{name: "tester1", state: "On", osShutdown: tc.osShutdown}
+			second := &shutdownTestProvider{name: "tester2", state: "On"}
+			registry := registrar.NewRegistry()
+			registry.Register(first.name, "tester", nil, nil, first)
+			registry.Register(second.name, "tester", nil, nil, second)
+			cl := NewClient("", "", "", WithRegistry(registry))
+
+			opts := GracefulPowerOffOptions{
+				GracePeriod:        20 * time.Millisecond,
+				PollInterval:       time.Millisecond,
+				PollProvider:       tc.escalateWith,
+				EscalationProvider: tc.escalateWith,
+			}
+
+			result, err := cl.GracefulPowerOff(context.Background(), opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, result.Escalated(), tc.wantEscalated)
+			assert.Equal(t, cl.GetMetadata().Escalated, tc.wantEscalated)
+			assert.Equal(t, first.actions, tc.wantActions)
+			if tc.escalateWith != "" {
+				assert.Equal(t, second.actions, []string{"off"})
+				assert.Equal(t, result.Hard.Provider, tc.escalateWith)
+			}
+		})
+	}
+}
@@ -0,0 +1,271 @@
+package bmclib
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"dario.cat/mergo"
+	"github.com/ghodss/yaml"
+	"github.com/metal-toolbox/bmclib/providers/rpc"
+)
+
+// Config is the declarative configuration of a Client, it is loaded from a YAML or JSON file with LoadConfig
+// and turned into Client options with the Options method.
+//
+//	perProviderTimeout: 30s
+//	ipmitool:
+//	  cipherSuite: "17"
+//	vendors:
+//	  dell:
+//	    redfish:
+//	      versionsNotCompatible: ["1.0.0"]
+//	hosts:
+//	  10.1.2.3:
+//	    vendor: dell
+//	    ipmitool:
+//	      port: "6230"
+//
+// The settings of a host are resolved by applying the vendor overrides and then the host overrides
+// to the top level settings, settings left empty in an override keep their value.
+// Boolean settings are only overridden when set, a host can set them to false to disable a top level setting.
+type Config struct {
+	Settings `json:",inline"`
+	// Vendors are the overrides applied to the hosts of the vendor, keyed by the vendor name.
+	Vendors map[string]Settings `json:"vendors,omitempty"`
+	// Hosts are the overrides applied to a host, keyed by the host as passed to NewClient.
+	Hosts map[string]Settings `json:"hosts,omitempty"`
+}
+
+// Settings are the Client settings of a Config.
+type Settings struct {
+	// Vendor selects the vendor overrides applied to the host.
+	Vendor             string      `json:"vendor,omitempty"`
+	PerProviderTimeout Duration    `json:"perProviderTimeout,omitempty"`
+	TLS                TLSSettings `json:"tls,omitempty"`
+	// DisabledProviders are the names of the built-in providers not to register.
+	DisabledProviders []string         `json:"disabledProviders,omitempty"`
+	Ipmitool          IpmitoolSettings `json:"ipmitool,omitempty"`
+	Asrockrack        PortSettings     `json:"asrockrack,omitempty"`
+	Redfish           RedfishSettings  `json:"redfish,omitempty"`
+	IntelAMT          IntelAMTSettings `json:"intelamt,omitempty"`
+	Dell              RedfishSettings  `json:"dell,omitempty"`
+	Supermicro        PortSettings     `json:"supermicro,omitempty"`
+	OpenBMC           PortSettings     `json:"openbmc,omitempty"`
+	RPC               RPCSettings      `json:"rpc,omitempty"`
+}
+
+// TLSSettings configures the verification of the BMC TLS certificates.
+type TLSSettings struct {
+	// Secure enforces trusted TLS connections.
+	Secure *bool `json:"secure,omitempty"`
+	// RootCAFile is the path to the PEM encoded CA certificates to trust, the system CAs are used when not set.
+	RootCAFile string `json:"rootCAFile,omitempty"`
+}
+
+// IpmitoolSettings are the ipmitool provider settings.
+type IpmitoolSettings struct {
+	Port         string `json:"port,omitempty"`
+	CipherSuite  string `json:"cipherSuite,omitempty"`
+	IpmitoolPath string `json:"ipmitoolPath,omitempty"`
+}
+
+// PortSettings are the settings of providers that only have a configurable port.
+type PortSettings struct {
+	Port string `json:"port,omitempty"`
+}
+
+// RedfishSettings are the redfish and dell provider settings.
+type RedfishSettings struct {
+	Port                  string   `json:"port,omitempty"`
+	VersionsNotCompatible []string `json:"versionsNotCompatible,omitempty"`
+	UseBasicAuth          *bool    `json:"useBasicAuth,omitempty"`
+	// DisableEtagMatch and SystemName only apply to the redfish provider.
+	DisableEtagMatch *bool  `json:"disableEtagMatch,omitempty"`
+	SystemName       string `json:"systemName,omitempty"`
+}
+
+// IntelAMTSettings are the intelamt provider settings.
+type IntelAMTSettings struct {
+	HostScheme string `json:"hostScheme,omitempty"`
+	Port       uint32 `json:"port,omitempty"`
+}
+
+// RPCSettings are the rpc provider settings.
+type RPCSettings struct {
+	ConsumerURL string `json:"consumerURL,omitempty"`
+	// Secrets are the HMAC secrets used to sign the requests, keyed by algorithm, for example sha256.
+	Secrets                  rpc.Secrets `json:"secrets,omitempty"`
+	LogNotificationsDisabled *bool       `json:"logNotificationsDisabled,omitempty"`
+}
+
+// Duration is a time.Duration that is read from a duration string like "30s".
+type Duration time.Duration
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("duration must be a string like \"30s\": %w", err)
+	}
+
+	duration, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+
+	*d = Duration(duration)
+
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// LoadConfig reads the Config from the YAML or JSON file at path.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := ParseConfig(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return cfg, nil
+}
+
+// ParseConfig parses the Config from YAML or JSON data, unknown settings are reported as an error.
+func ParseConfig(data []byte) (*Config, error) {
+	// JSON is valid YAML, converting to JSON lets the json struct tags be used for both formats.
+	jsonData, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &Config{}
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(cfg); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// For returns the settings of the host, with the vendor and host overrides applied.
+func (c *Config) For(host string) (Settings, error) {
+	settings := c.Settings
+	hostSettings := c.Hosts[host]
+
+	vendor := settings.Vendor
+	if hostSettings.Vendor != "" {
+		vendor = hostSettings.Vendor
+	}
+
+	for name, vendorSettings := range c.Vendors {
+		if vendor != "" && strings.EqualFold(name, vendor) {
+			if err := mergo.Merge(&settings, vendorSettings, mergo.WithOverride, mergo.WithoutDereference); err != nil {
+				return Settings{}, fmt.Errorf("vendor %s: %w", name, err)
+			}
+		}
+	}
+
+	// WithoutDereference overrides the boolean settings that are set to false, instead of skipping them as empty values.
+	if err := mergo.Merge(&settings, hostSettings, mergo.WithOverride, mergo.WithoutDereference); err != nil {
+		return Settings{}, fmt.Errorf("host %s: %w", host, err)
+	}
+
+	return settings, nil
+}
+
+// Options returns the Client options for the settings of the host,
+// options passed to NewClient after these take precedence.
+func (c *Config) Options(host string) ([]Option, error) {
+	settings, err := c.For(host)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []Option{settings.apply}
+
+	if settings.PerProviderTimeout > 0 {
+		opts = append(opts, WithPerProviderTimeout(time.Duration(settings.PerProviderTimeout)))
+	}
+
+	if len(settings.DisabledProviders) > 0 {
+		opts = append(opts, WithoutBuiltinProviders(settings.DisabledProviders...))
+	}
+
+	if settings.TLS.Secure != nil && *settings.TLS.Secure {
+		var rootCAs *x509.CertPool
+		if settings.TLS.RootCAFile != "" {
+			pem, err := os.ReadFile(settings.TLS.RootCAFile)
+			if err != nil {
+				return nil, err
+			}
+
+			rootCAs = x509.NewCertPool()
+			if !rootCAs.AppendCertsFromPEM(pem) {
+				return nil, fmt.Errorf("no certificates found in %s", settings.TLS.RootCAFile)
+			}
+		}
+
+		opts = append(opts, WithSecureTLS(rootCAs))
+	}
+
+	return opts, nil
+}
+
+// apply sets the provider configuration of the Client, empty settings keep the Client defaults.
+func (s Settings) apply(args *Client) {
+	setString(&args.providerConfig.ipmitool.Port, s.Ipmitool.Port)
+	setString(&args.providerConfig.ipmitool.CipherSuite, s.Ipmitool.CipherSuite)
+	setString(&args.providerConfig.ipmitool.IpmitoolPath, s.Ipmitool.IpmitoolPath)
+
+	setString(&args.providerConfig.asrock.Port, s.Asrockrack.Port)
+
+	setString(&args.providerConfig.gofish.Port, s.Redfish.Port)
+	setString(&args.providerConfig.gofish.SystemName, s.Redfish.SystemName)
+	args.providerConfig.gofish.VersionsNotCompatible = append(args.providerConfig.gofish.VersionsNotCompatible, s.Redfish.VersionsNotCompatible...)
+	setBool(&args.providerConfig.gofish.UseBasicAuth, s.Redfish.UseBasicAuth)
+	setBool(&args.providerConfig.gofish.DisableEtagMatch, s.Redfish.DisableEtagMatch)
+
+	setString(&args.providerConfig.intelamt.HostScheme, s.IntelAMT.HostScheme)
+	if s.IntelAMT.Port != 0 {
+		args.providerConfig.intelamt.Port = s.IntelAMT.Port
+	}
+
+	setString(&args.providerConfig.dell.Port, s.Dell.Port)
+	args.providerConfig.dell.VersionsNotCompatible = append(args.providerConfig.dell.VersionsNotCompatible, s.Dell.VersionsNotCompatible...)
+	setBool(&args.providerConfig.dell.UseBasicAuth, s.Dell.UseBasicAuth)
+
+	setString(&args.providerConfig.supermicro.Port, s.Supermicro.Port)
+	setString(&args.providerConfig.openbmc.Port, s.OpenBMC.Port)
+
+	setString(&args.providerConfig.rpc.ConsumerURL, s.RPC.ConsumerURL)
+	if len(s.RPC.Secrets) > 0 {
+		args.providerConfig.rpc.Opts.HMAC.Secrets = s.RPC.Secrets
+	}
+	setBool(&args.providerConfig.rpc.LogNotificationsDisabled, s.RPC.LogNotificationsDisabled)
+}
+
+func setString(field *string, value string) {
+	if value != "" {
+		*field = value
+	}
+}
+
+func setBool(field *bool, value *bool) {
+	if value != nil {
+		*field = *value
+	}
+}
@@ -0,0 +1,152 @@
+package bmclib
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/metal-toolbox/bmclib/providers/rpc"
+	"gopkg.in/go-playground/assert.v1"
+)
+
+func TestLoadConfig(t *testing.T) {
+	data := `
+perProviderTimeout: 30s
+disabledProviders: [intelamt]
+ipmitool:
+  cipherSuite: "17"
+redfish:
+  versionsNotCompatible: ["1.0.0"]
+rpc:
+  consumerURL: http://127.0.0.1/rpc
+  secrets:
+    sha256: [superSecret1]
+vendors:
+  Dell:
+    ipmitool:
+      port: "6230"
+hosts:
+  10.1.2.3:
+    vendor: dell
+    ipmitool:
+      cipherSuite: "3"
+`
+	path := filepath.Join(t.TempDir(), "bmclib.yaml")
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		host        string
+		port        string
+		cipherSuite string
+	}{
+		"defaults":                  {host: "10.1.2.4", port: "623", cipherSuite: "17"},
+		"vendor and host overrides": {host: "10.1.2.3", port: "6230", cipherSuite: "3"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			opts, err := cfg.Options(tc.host)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cl := NewClient(tc.host, "", "", opts...)
+			assert.Equal(t, cl.providerConfig.ipmitool.Port, tc.port)
+			assert.Equal(t, cl.providerConfig.ipmitool.CipherSuite, tc.cipherSuite)
+			assert.Equal(t, cl.providerConfig.gofish.VersionsNotCompatible, []string{"1.0.0"})
+			assert.Equal(t, cl.providerConfig.rpc.ConsumerURL, "http://127.0.0.1/rpc")
+			assert.Equal(t, cl.providerConfig.rpc.Opts.HMAC.Secrets, rpc.Secrets{rpc.SHA256: {"superSecret1"}})
+			assert.Equal(t, cl.perProviderTimeout(context.Background()), 30*time.Second)
+			assert.Equal(t, cl.builtinProviderEnabled("intelamt"), false)
+		})
+	}
+}
+
+func TestParseConfigErrors(t *testing.T) {
+	tests := map[string]string{
+		"unknown setting":  `{"ipmitool": {"cipher": "17"}}`,
+		"invalid duration": `perProviderTimeout: 30`,
+	}
+
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ParseConfig([]byte(data)); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
+
+func TestConfigOverrideDisablesSetting(t *testing.T) {
+	data := `
+tls:
+  secure: true
+redfish:
+  useBasicAuth: true
+dell:
+  useBasicAuth: true
+rpc:
+  logNotificationsDisabled: true
+vendors:
+  dell:
+    dell:
+      useBasicAuth: false
+hosts:
+  10.1.2.3:
+    tls:
+      secure: false
+    redfish:
+      useBasicAuth: false
+    rpc:
+      logNotificationsDisabled: false
+  10.1.2.4:
+    vendor: dell
+`
+	cfg, err := ParseConfig([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		host                     string
+		secure                   bool
+		redfishBasicAuth         bool
+		dellBasicAuth            bool
+		logNotificationsDisabled bool
+	}{
+		"top level settings": {host: "10.1.2.5", secure: true, redfishBasicAuth: true, dellBasicAuth: true, logNotificationsDisabled: true},
+		"host overrides":     {host: "10.1.2.3", secure: false, redfishBasicAuth: false, dellBasicAuth: true, logNotificationsDisabled: false},
+		"vendor overrides":   {host: "10.1.2.4", secure: true, redfishBasicAuth: true, dellBasicAuth: false, logNotificationsDisabled: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			settings, err := cfg.For(tc.host)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, *settings.TLS.Secure, tc.secure)
+
+			opts, err := cfg.Options(tc.host)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cl := NewClient(tc.host, "", "", opts...)
+			assert.Equal(t, cl.providerConfig.gofish.UseBasicAuth, tc.redfishBasicAuth)
+			assert.Equal(t, cl.providerConfig.dell.UseBasicAuth, tc.dellBasicAuth)
+			assert.Equal(t, cl.providerConfig.rpc.LogNotificationsDisabled, tc.logNotificationsDisabled)
+			assert.Equal(t, len(cl.httpClientSetupFuncs) > 0, tc.secure)
+		})
+	}
+}
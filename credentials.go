@@ -0,0 +1,489 @@
+package bmclib
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/jacobweinstock/registrar"
+	"github.com/metal-toolbox/bmclib/bmc"
+	"github.com/metal-toolbox/bmclib/credentials"
+	bmclibErrs "github.com/metal-toolbox/bmclib/errors"
+)
+
+// CredentialProvider returns the credentials used to authenticate with a BMC,
+// the credentials package provides static, environment, file and HTTP secret store implementations.
+type CredentialProvider interface {
+	Credentials(ctx context.Context, host string) (credentials.Credentials, error)
+}
+
+// CredentialRotationError is returned by RotateCredentials when the password was updated on the BMC
+// but could not be verified with a new session.
+type CredentialRotationError struct {
+	// Provider is the name of the provider that updated the password.
+	Provider string
+	// Unverified are the names of the providers that failed to authenticate with the new password.
+	Unverified []string
+	// RolledBack is set when the previous password was restored on the BMC.
+	// When not set the BMC password is unknown, it is likely to be the new password.
+	RolledBack bool
+	// Err is the verification error, along with the rollback error when the previous password could not be restored.
+	Err error
+}
+
+// Error implements the error interface
+func (e *CredentialRotationError) Error() string {
+	msg := fmt.Sprintf("password updated by %s could not be verified", e.Provider)
+	if len(e.Unverified) > 0 {
+		msg += " with " + strings.Join(e.Unverified, ", ")
+	}
+
+	if e.RolledBack {
+		return fmt.Sprintf("%s, the previous password was restored: %s", msg, e.Err.Error())
+	}
+
+	return fmt.Sprintf("%s and the previous password could not be restored: %s", msg, e.Err.Error())
+}
+
+// Unwrap returns the underlying error.
+func (e *CredentialRotationError) Unwrap() error {
+	return e.Err
+}
+
+// RotateCredentials updates the password of the user the Client is authenticated as,
+// it is expected to be called after Open.
+//
+// The password is updated with the first provider that implements bmc.UserUpdater,
+// the role is passed on to the provider, it is required by providers like asrockrack.
+// The new password is then verified by opening new connections for the provider that updated it
+// and every provider with an open connection, on success the previous connections are closed
+// and the Client switches to the new connections and credentials.
+//
+// When any of them fails to authenticate with the new password, the previous password is restored
+// with the providers that did authenticate with the new password, a CredentialRotationError
+// listing the providers that failed is returned and the Client credentials are left unchanged.
+// A CredentialProvider set on the Client is expected to return the new credentials once the rotation succeeded.
+func (c *Client) RotateCredentials(ctx context.Context, pass, role string) error {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "RotateCredentials")
+	defer span.End()
+
+	ctx = c.withCallOptions(ctx)
+
+	if c.registrySupplied {
+		return errors.New("credentials cannot be rotated for providers in a registry set with WithRegistry")
+	}
+
+	defer c.lockConnections()()
+
+	current := c.currentCredentials()
+	registry := c.registry()
+	_, metadata, err := bmc.UpdateUserFromInterfaces(ctx, c.perProviderTimeout(ctx), current.User, pass, role, registry.GetDriverInterfaces())
+	c.setMetadata(ctx, metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+	if err != nil {
+		return err
+	}
+
+	previous := current
+	rotated := credentials.Credentials{User: current.User, Pass: pass}
+
+	drivers := c.drivers()
+	var candidates registrar.Drivers
+	for _, driver := range c.buildProviders(rotated) {
+		if slices.ContainsFunc(drivers, func(d *registrar.Driver) bool { return d.Name == driver.Name }) {
+			candidates = append(candidates, driver)
+		}
+	}
+
+	candidateRegistry := registrar.Registry{Drivers: candidates}
+	ifs, verifyMetadata, verifyErr := bmc.OpenConnectionFromInterfaces(ctx, c.perProviderTimeout(ctx), candidateRegistry.GetDriverInterfaces())
+
+	var opened registrar.Drivers
+	for _, driver := range candidates {
+		if slices.Contains(ifs, driver.DriverInterface) {
+			opened = append(opened, driver)
+		}
+	}
+
+	// the provider that updated the password and the providers with an open connection
+	// are required to authenticate with the new password.
+	required := []string{metadata.SuccessfulProvider}
+	for _, driver := range c.connectedDrivers(drivers) {
+		if !slices.Contains(required, driver.Name) {
+			required = append(required, driver.Name)
+		}
+	}
+
+	var unverified []string
+	for _, name := range required {
+		if failed, ok := verifyMetadata.FailedProviderErrors[name]; ok {
+			unverified = append(unverified, name)
+			verifyErr = multierror.Append(verifyErr, failed)
+		}
+	}
+
+	if len(unverified) > 0 || len(opened) == 0 {
+		// providers that don't open a connection authenticate on each call, they are used for the rollback
+		// along with the opened providers.
+		var authenticated registrar.Drivers
+		for _, driver := range candidates {
+			if _, failed := verifyMetadata.FailedProviderErrors[driver.Name]; !failed {
+				authenticated = append(authenticated, driver)
+			}
+		}
+
+		return c.rollbackCredentials(ctx, previous, role, metadata.SuccessfulProvider, unverified, authenticated, verifyErr)
+	}
+
+	c.closeConnected(ctx, drivers)
+
+	c.setConnected(opened, true)
+	c.setDriversAndAuth(candidates, rotated)
+
+	return nil
+}
+
+// rollbackCredentials restores the previous password on the BMC with the given providers, constructed with the new password,
+// since the sessions of the providers opened with the previous password may have been invalidated by the update.
+// The providers are closed and a CredentialRotationError is returned.
+func (c *Client) rollbackCredentials(ctx context.Context, previous credentials.Credentials, role, provider string, unverified []string, authenticated registrar.Drivers, verifyErr error) error {
+	rotationErr := &CredentialRotationError{Provider: provider, Unverified: unverified, RolledBack: true, Err: verifyErr}
+	if verifyErr == nil {
+		rotationErr.Err = errors.New("no provider opened a connection with the new password")
+	}
+
+	if len(authenticated) == 0 {
+		rotationErr.RolledBack = false
+		rotationErr.Err = multierror.Append(rotationErr.Err, errors.New("no provider authenticated with the new password to restore the previous password"))
+
+		return rotationErr
+	}
+
+	registry := registrar.Registry{Drivers: authenticated}
+	_, _, rollbackErr := bmc.UpdateUserFromInterfaces(ctx, c.perProviderTimeout(ctx), previous.User, previous.Pass, role, registry.GetDriverInterfaces())
+	if rollbackErr != nil {
+		rotationErr.RolledBack = false
+		rotationErr.Err = multierror.Append(rotationErr.Err, rollbackErr)
+	}
+
+	if _, err := bmc.CloseConnectionFromInterfaces(ctx, registry.GetDriverInterfaces()); err != nil {
+		c.Logger.Info("failed to close connections opened with the new credentials", "error", err.Error())
+	}
+
+	return rotationErr
+}
+
+// refreshCredentials gets the credentials for the Client host from the CredentialProvider,
+// the Client is updated when they differ from its credentials, the caller is expected to recreate the providers.
+func (c *Client) refreshCredentials(ctx context.Context) (changed bool, err error) {
+	creds, err := c.credentialProvider.Credentials(ctx, c.Auth.Host)
+	if err != nil {
+		return false, fmt.Errorf("error getting credentials for %s: %w", c.Auth.Host, err)
+	}
+
+	if current := c.currentCredentials(); creds.User == current.User && creds.Pass == current.Pass {
+		return false, nil
+	}
+
+	c.setAuth(creds)
+
+	return true, nil
+}
+
+// reauthenticate requests the credentials again when a read only method call failed because a provider failed to authenticate,
+// when they were updated the providers are recreated and opened with them. It returns true when the method call
+// is to be made again with the reopened providers.
+//
+// Methods that change the BMC state use reauthenticateMutation.
+func (c *Client) reauthenticate(ctx context.Context, metadata bmc.Metadata, err error) bool {
+	if err == nil || !authFailed(metadata) {
+		return false
+	}
+
+	return c.reopenWithRefreshedCredentials(ctx)
+}
+
+// reauthenticateMutation is reauthenticate for methods that change the BMC state, the method call is
+// made again only when every provider attempted failed to authenticate, a provider that failed otherwise
+// may have applied the change before failing and the change is not to be made twice.
+func (c *Client) reauthenticateMutation(ctx context.Context, metadata bmc.Metadata, err error) bool {
+	if err == nil || !allAuthFailed(metadata) {
+		return false
+	}
+
+	return c.reopenWithRefreshedCredentials(ctx)
+}
+
+// reopenWithRefreshedCredentials requests the credentials again, when they were updated the providers
+// are recreated and opened with them. It returns true when the providers were reopened.
+func (c *Client) reopenWithRefreshedCredentials(ctx context.Context) bool {
+	if c.credentialProvider == nil || c.registrySupplied {
+		return false
+	}
+
+	defer c.lockConnections()()
+
+	// the providers are reopened with the Client views are created from, so that all the providers
+	// in the registry are reopened when the method was called with a view.
+	root := c.rootClient()
+	changed, refreshErr := root.refreshCredentials(ctx)
+	if refreshErr != nil {
+		c.Logger.Info("failed to refresh credentials after an authentication failure", "error", refreshErr.Error())
+		return false
+	}
+
+	if !changed {
+		return false
+	}
+
+	root.rebuildProviders(ctx)
+	if _, openErr := root.open(ctx); openErr != nil {
+		c.Logger.Info("failed to open connections with the refreshed credentials", "error", openErr.Error())
+		return false
+	}
+
+	return true
+}
+
+// currentCredentials returns the credentials the providers are constructed with.
+//
+// The credentials are kept on the Client views are created from, the Auth field of a view
+// is a copy made when the view was created and is not updated when sibling views update the credentials.
+func (c *Client) currentCredentials() credentials.Credentials {
+	root := c.rootClient()
+	if root.registryLock != nil {
+		root.registryLock.RLock()
+		defer root.registryLock.RUnlock()
+	}
+
+	return credentials.Credentials{User: root.Auth.User, Pass: root.Auth.Pass}
+}
+
+// setAuth sets the credentials on the Client the view was created from, and on the view itself.
+func (c *Client) setAuth(creds credentials.Credentials) {
+	root := c.rootClient()
+	if root.registryLock != nil {
+		root.registryLock.Lock()
+		defer root.registryLock.Unlock()
+	}
+
+	for _, cl := range []*Client{c, root} {
+		cl.Auth.User = creds.User
+		cl.Auth.Pass = creds.Pass
+	}
+}
+
+// setDriversAndAuth replaces the registry drivers and sets the credentials they were opened with,
+// under the same lock so that the Client and its views never use the drivers with the other credentials.
+func (c *Client) setDriversAndAuth(drivers registrar.Drivers, creds credentials.Credentials) {
+	root := c.rootClient()
+	if root.registryLock != nil {
+		root.registryLock.Lock()
+		defer root.registryLock.Unlock()
+	}
+
+	root.Registry.Drivers = drivers
+	for _, cl := range []*Client{c, root} {
+		cl.Auth.User = creds.User
+		cl.Auth.Pass = creds.Pass
+	}
+}
+
+// rebuildProviders recreates the providers in the registry with the Client credentials,
+// the connections of the providers replaced are closed first.
+//
+// Only the providers in the registry are recreated, in the registry order, so providers removed from the registry,
+// for example with `cl.Registry.Drivers = cl.Registry.For("gofish")`, are not added back.
+// Providers in a registry set with WithRegistry are not recreated since they were constructed by the caller.
+func (c *Client) rebuildProviders(ctx context.Context) {
+	if c.registrySupplied {
+		c.Logger.Info("providers in the registry set with WithRegistry are not recreated with the updated credentials")
+		return
+	}
+
+	drivers := c.drivers()
+	built := c.buildProviders(c.currentCredentials())
+
+	var replaced, rebuilt registrar.Drivers
+	for _, driver := range drivers {
+		index := slices.IndexFunc(built, func(d *registrar.Driver) bool { return d.Name == driver.Name })
+		if index < 0 {
+			// providers registered on the Client registry directly can't be recreated.
+			rebuilt = append(rebuilt, driver)
+			continue
+		}
+
+		replaced = append(replaced, driver)
+		rebuilt = append(rebuilt, built[index])
+	}
+
+	c.closeConnected(ctx, replaced)
+	c.setDrivers(rebuilt)
+}
+
+// closeConnected closes the connections of the given drivers that have an open connection.
+func (c *Client) closeConnected(ctx context.Context, drivers registrar.Drivers) {
+	connected := c.connectedDrivers(drivers)
+	if len(connected) == 0 {
+		return
+	}
+
+	registry := registrar.Registry{Drivers: connected}
+	if _, err := bmc.CloseConnectionFromInterfaces(ctx, registry.GetDriverInterfaces()); err != nil {
+		c.Logger.Info("failed to close connections opened with the previous credentials", "error", err.Error())
+	}
+
+	c.setConnected(connected, false)
+}
+
+// buildProviders returns the built-in and external providers constructed with the given credentials,
+// the Client registry is left as is.
+func (c *Client) buildProviders(creds credentials.Credentials) registrar.Drivers {
+	builder := *c
+	builder.Auth.User = creds.User
+	builder.Auth.Pass = creds.Pass
+	builder.Registry = &registrar.Registry{Logger: c.Logger}
+	builder.registerProviders()
+	builder.registerExternalProviders()
+
+	return builder.Registry.Drivers
+}
+
+// openWithFallbackCredentials opens the providers that failed to authenticate with the Client credentials
+// using the fallback credentials, in order, until they are all opened or the fallback credentials are exhausted.
+//
+// order holds the provider names in the registry order before Open, the opened providers are kept in that order.
+func (c *Client) openWithFallbackCredentials(ctx context.Context, order []string, metadata bmc.Metadata, openErr error) (bmc.Metadata, error) {
+	var opened registrar.Drivers
+	if openErr == nil {
+		opened = c.drivers()
+	}
+
+	metadata.ProviderCredentials = make(map[string]int)
+	for _, driver := range opened {
+		metadata.ProviderCredentials[driver.Name] = 0
+	}
+
+	failed := authFailedProviders(metadata)
+	for i, creds := range c.fallbackCredentials {
+		if len(failed) == 0 {
+			break
+		}
+
+		var candidates registrar.Drivers
+		for _, driver := range c.buildProviders(creds) {
+			if slices.Contains(failed, driver.Name) {
+				candidates = append(candidates, driver)
+			}
+		}
+
+		candidateRegistry := registrar.Registry{Drivers: candidates}
+		ifs, fallbackMetadata, err := bmc.OpenConnectionFromInterfaces(ctx, c.perProviderTimeout(ctx), candidateRegistry.GetDriverInterfaces())
+		mergeOpenMetadata(&metadata, fallbackMetadata)
+		if err != nil {
+			openErr = multierror.Append(openErr, err)
+		}
+
+		for _, driver := range candidates {
+			if slices.Contains(ifs, driver.DriverInterface) {
+				opened = append(opened, driver)
+				metadata.ProviderCredentials[driver.Name] = i + 1
+				delete(metadata.FailedProviderDetail, driver.Name)
+				delete(metadata.FailedProviderErrors, driver.Name)
+			}
+		}
+
+		failed = authFailedProviders(metadata)
+	}
+
+	if len(opened) == 0 {
+		return metadata, openErr
+	}
+
+	slices.SortStableFunc(opened, func(a, b *registrar.Driver) int {
+		return slices.Index(order, a.Name) - slices.Index(order, b.Name)
+	})
+	c.setConnected(opened, true)
+
+	// when no provider could be opened with the Client credentials, the Client takes
+	// on the fallback credentials that opened the first provider.
+	if index := metadata.ProviderCredentials[opened[0].Name]; index > 0 && !openedWithClientCredentials(metadata) {
+		c.setDriversAndAuth(opened, c.fallbackCredentials[index-1])
+	} else {
+		c.setDrivers(opened)
+	}
+
+	return metadata, nil
+}
+
+// mergeOpenMetadata adds the metadata of a subsequent OpenConnectionFromInterfaces call to metadata.
+func mergeOpenMetadata(metadata *bmc.Metadata, next bmc.Metadata) {
+	metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, next.ProvidersAttempted...)
+	metadata.SuccessfulOpenConns = append(metadata.SuccessfulOpenConns, next.SuccessfulOpenConns...)
+
+	for provider, detail := range next.FailedProviderDetail {
+		metadata.FailedProviderDetail[provider] = detail
+	}
+
+	for provider, err := range next.FailedProviderErrors {
+		if metadata.FailedProviderErrors == nil {
+			metadata.FailedProviderErrors = make(map[string]*bmclibErrs.ProviderError)
+		}
+
+		metadata.FailedProviderErrors[provider] = err
+	}
+
+	for provider, attempts := range next.ProviderAttempts {
+		if metadata.ProviderAttempts == nil {
+			metadata.ProviderAttempts = make(map[string]int)
+		}
+
+		metadata.ProviderAttempts[provider] = attempts
+	}
+}
+
+// openedWithClientCredentials returns true when a provider was opened with the Client credentials.
+func openedWithClientCredentials(metadata bmc.Metadata) bool {
+	for _, index := range metadata.ProviderCredentials {
+		if index == 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// authFailed returns true when a provider failed to authenticate with the BMC.
+func authFailed(metadata bmc.Metadata) bool {
+	return len(authFailedProviders(metadata)) > 0
+}
+
+// allAuthFailed returns true when every provider attempted failed to authenticate with the BMC.
+func allAuthFailed(metadata bmc.Metadata) bool {
+	if len(metadata.ProvidersAttempted) == 0 {
+		return false
+	}
+
+	for _, provider := range metadata.ProvidersAttempted {
+		if err, ok := metadata.FailedProviderErrors[provider]; !ok || err.Class != bmclibErrs.ErrorClassAuth {
+			return false
+		}
+	}
+
+	return true
+}
+
+// authFailedProviders returns the names of the providers that failed to authenticate with the BMC.
+func authFailedProviders(metadata bmc.Metadata) []string {
+	var failed []string
+	for provider, err := range metadata.FailedProviderErrors {
+		if err.Class == bmclibErrs.ErrorClassAuth {
+			failed = append(failed, provider)
+		}
+	}
+
+	return failed
+}
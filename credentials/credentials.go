@@ -0,0 +1,157 @@
+// Package credentials provides sources of the credentials used to authenticate with a BMC,
+// they are set on the bmclib Client with the WithCredentialProvider option.
+package credentials
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/ghodss/yaml"
+)
+
+var (
+	// ErrNotFound is returned when no credentials are available for the host.
+	ErrNotFound = errors.New("credentials not found")
+)
+
+// Credentials are the user and password used to authenticate with a BMC.
+type Credentials struct {
+	User string `json:"user"`
+	Pass string `json:"pass"`
+}
+
+// Static returns the same credentials for all hosts.
+type Static Credentials
+
+// Credentials implements the bmclib.CredentialProvider interface
+func (s Static) Credentials(_ context.Context, _ string) (Credentials, error) {
+	return Credentials(s), nil
+}
+
+// Env reads the credentials from environment variables each time they are requested.
+type Env struct {
+	// UserVar is the name of the environment variable holding the user, defaults to BMC_USER.
+	UserVar string
+	// PassVar is the name of the environment variable holding the password, defaults to BMC_PASS.
+	PassVar string
+}
+
+// Credentials implements the bmclib.CredentialProvider interface
+func (e Env) Credentials(_ context.Context, _ string) (Credentials, error) {
+	userVar, passVar := e.UserVar, e.PassVar
+	if userVar == "" {
+		userVar = "BMC_USER"
+	}
+
+	if passVar == "" {
+		passVar = "BMC_PASS"
+	}
+
+	user, ok := os.LookupEnv(userVar)
+	if !ok {
+		return Credentials{}, fmt.Errorf("%w: %s is not set", ErrNotFound, userVar)
+	}
+
+	pass, ok := os.LookupEnv(passVar)
+	if !ok {
+		return Credentials{}, fmt.Errorf("%w: %s is not set", ErrNotFound, passVar)
+	}
+
+	return Credentials{User: user, Pass: pass}, nil
+}
+
+// File reads the credentials from a YAML or JSON file each time they are requested,
+// so that a file updated by a secret store agent is picked up.
+//
+// The file holds the credentials keyed by host, the credentials keyed by "*" are used for hosts not listed.
+//
+//	"10.1.2.3":
+//	  user: admin
+//	  pass: secret
+//	"*":
+//	  user: root
+//	  pass: secret
+type File struct {
+	Path string
+}
+
+// Credentials implements the bmclib.CredentialProvider interface
+func (f File) Credentials(_ context.Context, host string) (Credentials, error) {
+	data, err := os.ReadFile(f.Path)
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	var byHost map[string]Credentials
+	if err := yaml.Unmarshal(data, &byHost); err != nil {
+		return Credentials{}, fmt.Errorf("%s: %w", f.Path, err)
+	}
+
+	if creds, ok := byHost[host]; ok {
+		return creds, nil
+	}
+
+	if creds, ok := byHost["*"]; ok {
+		return creds, nil
+	}
+
+	return Credentials{}, fmt.Errorf("%w: %s in %s", ErrNotFound, host, f.Path)
+}
+
+// HTTP requests the credentials from a secret store over HTTP each time they are requested.
+//
+// The secret store is expected to respond to a GET request with the credentials in JSON,
+// for example {"user": "admin", "pass": "secret"}, and a 404 status code when there are none for the host.
+type HTTP struct {
+	// URL is the secret store URL, any {host} in the URL is replaced by the BMC host.
+	// For example, https://secrets.example.com/bmc/{host}
+	URL string
+	// Header is added to the requests, for example to set an Authorization header.
+	Header http.Header
+	// Client is the http client for the requests, defaults to http.DefaultClient.
+	Client *http.Client
+}
+
+// Credentials implements the bmclib.CredentialProvider interface
+func (h HTTP) Credentials(ctx context.Context, host string) (Credentials, error) {
+	client := h.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	endpoint := strings.ReplaceAll(h.URL, "{host}", url.PathEscape(host))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	for k, v := range h.Header {
+		req.Header[k] = v
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return Credentials{}, err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return Credentials{}, fmt.Errorf("%w: %s", ErrNotFound, host)
+	case resp.StatusCode != http.StatusOK:
+		return Credentials{}, fmt.Errorf("unexpected status code from secret store: %d", resp.StatusCode)
+	}
+
+	var creds Credentials
+	if err := json.NewDecoder(resp.Body).Decode(&creds); err != nil {
+		return Credentials{}, fmt.Errorf("error decoding secret store response: %w", err)
+	}
+
+	return creds, nil
+}
@@ -0,0 +1,86 @@
+package credentials
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnv(t *testing.T) {
+	t.Setenv("TEST_BMC_USER", "admin")
+	t.Setenv("TEST_BMC_PASS", "secret")
+
+	creds, err := Env{UserVar: "TEST_BMC_USER", PassVar: "TEST_BMC_PASS"}.Credentials(context.Background(), "10.1.2.3")
+	assert.Nil(t, err)
+	assert.Equal(t, Credentials{User: "admin", Pass: "secret"}, creds)
+
+	_, err = Env{UserVar: "TEST_BMC_USER", PassVar: "TEST_BMC_UNSET"}.Credentials(context.Background(), "10.1.2.3")
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials.yaml")
+	data := `
+"10.1.2.3":
+  user: admin
+  pass: secret
+"*":
+  user: root
+  pass: calvin
+`
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		host string
+		want Credentials
+	}{
+		{"host", "10.1.2.3", Credentials{User: "admin", Pass: "secret"}},
+		{"default", "10.1.2.4", Credentials{User: "root", Pass: "calvin"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			creds, err := File{Path: path}.Credentials(context.Background(), tc.host)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.want, creds)
+		})
+	}
+}
+
+func TestHTTP(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if r.URL.Path != "/bmc/10.1.2.3" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"user": "admin", "pass": "secret"}`))
+	}))
+	defer server.Close()
+
+	provider := HTTP{URL: server.URL + "/bmc/{host}", Header: http.Header{"Authorization": {"Bearer token"}}}
+
+	creds, err := provider.Credentials(context.Background(), "10.1.2.3")
+	assert.Nil(t, err)
+	assert.Equal(t, Credentials{User: "admin", Pass: "secret"}, creds)
+
+	_, err = provider.Credentials(context.Background(), "10.1.2.4")
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	_, err = HTTP{URL: server.URL + "/bmc/{host}"}.Credentials(context.Background(), "10.1.2.3")
+	assert.NotNil(t, err)
+}
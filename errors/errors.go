@@ -126,6 +126,9 @@ var (
 
 	// ErrBMCUpdating is returned when the BMC is going through an update and will not serve other queries.
 	ErrBMCUpdating = errors.New("a BMC firmware update is in progress")
+
+	// ErrPowerStateTimeout is returned when the expected power state was not observed within the wait timeout.
+	ErrPowerStateTimeout = errors.New("timed out waiting for power state")
 )
 
 type ErrUnsupportedHardware struct {
@@ -0,0 +1,239 @@
+package errors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+)
+
+// ErrorClass classifies a provider error to help callers decide how to handle it,
+// for example whether a retry is worthwhile.
+type ErrorClass string
+
+const (
+	// ErrorClassUnknown is set when the error could not be classified.
+	ErrorClassUnknown ErrorClass = "unknown"
+	// ErrorClassAuth is set for authentication and authorization failures.
+	ErrorClassAuth ErrorClass = "auth"
+	// ErrorClassTransient is set for errors that may succeed when retried, like network timeouts.
+	ErrorClassTransient ErrorClass = "transient"
+	// ErrorClassUnsupported is set when the BMC or provider does not support the operation.
+	ErrorClassUnsupported ErrorClass = "unsupported"
+	// ErrorClassBusy is set when the BMC is busy, for example with a firmware update or when out of sessions.
+	ErrorClassBusy ErrorClass = "bmc-busy"
+	// ErrorClassPermanent is set for errors that will not succeed when retried, like a bad request.
+	ErrorClassPermanent ErrorClass = "permanent"
+)
+
+// ProviderError is returned when a provider fails to execute an operation,
+// it includes the details required to classify the failure.
+//
+// Use errors.As to retrieve a ProviderError from errors returned by the bmclib Client methods.
+type ProviderError struct {
+	// Provider is the name of the provider that returned the error.
+	Provider string
+	// Operation is the provider method that returned the error.
+	Operation string
+	// StatusCode is the HTTP status code returned by the BMC, when available.
+	StatusCode int
+	// ExitCode is the exit code of the command executed by the provider (ipmitool, racadm), when available.
+	ExitCode int
+	// Class is the classification of the error.
+	Class ErrorClass
+	// Err is the underlying error.
+	Err error
+}
+
+// Error implements the error interface
+func (e *ProviderError) Error() string {
+	if e.Provider == "" {
+		return e.Err.Error()
+	}
+
+	return fmt.Sprintf("provider: %s: %s", e.Provider, e.Err.Error())
+}
+
+// Unwrap returns the underlying error.
+func (e *ProviderError) Unwrap() error {
+	return e.Err
+}
+
+// Retryable returns true when the error is classified as transient or the BMC was busy.
+func (e *ProviderError) Retryable() bool {
+	return e.Class == ErrorClassTransient || e.Class == ErrorClassBusy
+}
+
+// NewProviderError returns a ProviderError for the provider and operation.
+//
+// The status code, exit code and classification are carried over
+// from any ProviderError returned by the provider, otherwise the error is classified.
+func NewProviderError(provider, operation string, err error) *ProviderError {
+	pErr := &ProviderError{
+		Provider:  provider,
+		Operation: operation,
+		Class:     Classify(err),
+		Err:       err,
+	}
+
+	var inner *ProviderError
+	if errors.As(err, &inner) {
+		pErr.StatusCode = inner.StatusCode
+		pErr.ExitCode = inner.ExitCode
+	}
+
+	return pErr
+}
+
+// NewHTTPError returns a ProviderError for an unexpected HTTP status code returned by a BMC,
+// the error is classified based on the status code.
+func NewHTTPError(statusCode int, err error) *ProviderError {
+	return &ProviderError{
+		StatusCode: statusCode,
+		Class:      ClassifyHTTPStatus(statusCode),
+		Err:        err,
+	}
+}
+
+// NewExitCodeError returns a ProviderError for a command that exited with a non zero exit code,
+// the error is classified based on the command output.
+func NewExitCodeError(exitCode int, output string, err error) *ProviderError {
+	class := classifyText(output)
+	if class == ErrorClassUnknown {
+		class = Classify(err)
+	}
+
+	return &ProviderError{
+		ExitCode: exitCode,
+		Class:    class,
+		Err:      err,
+	}
+}
+
+// ClassifyHTTPStatus returns the ErrorClass for a HTTP status code.
+func ClassifyHTTPStatus(statusCode int) ErrorClass {
+	switch statusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ErrorClassAuth
+	case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return ErrorClassUnsupported
+	case http.StatusRequestTimeout, http.StatusTooManyRequests, http.StatusBadGateway, http.StatusGatewayTimeout:
+		return ErrorClassTransient
+	case http.StatusServiceUnavailable, http.StatusConflict:
+		return ErrorClassBusy
+	}
+
+	switch {
+	case statusCode >= 400 && statusCode < 500:
+		return ErrorClassPermanent
+	default:
+		return ErrorClassUnknown
+	}
+}
+
+// Classify returns the ErrorClass for the given error.
+//
+// An ErrorClass set on a ProviderError in the error chain is returned as is,
+// otherwise the error is classified based on known errors and the error message.
+func Classify(err error) ErrorClass {
+	if err == nil {
+		return ErrorClassUnknown
+	}
+
+	var pErr *ProviderError
+	if errors.As(err, &pErr) && pErr.Class != "" && pErr.Class != ErrorClassUnknown {
+		return pErr.Class
+	}
+
+	var unsupported *ErrUnsupportedHardware
+	var netErr net.Error
+
+	switch {
+	case errors.Is(err, ErrLoginFailed),
+		errors.Is(err, ErrNotAuthenticated),
+		errors.Is(err, ErrSessionExpired):
+		return ErrorClassAuth
+	case errors.Is(err, ErrNotImplemented),
+		errors.Is(err, ErrIncompatibleProvider),
+		errors.Is(err, ErrRedfishVersionIncompatible),
+		errors.As(err, &unsupported):
+		return ErrorClassUnsupported
+	case errors.Is(err, ErrBMCUpdating):
+		return ErrorClassBusy
+	case errors.Is(err, context.DeadlineExceeded),
+		errors.Is(err, io.EOF),
+		errors.Is(err, io.ErrUnexpectedEOF),
+		errors.Is(err, syscall.ECONNREFUSED),
+		errors.Is(err, syscall.ECONNRESET),
+		errors.As(err, &netErr):
+		return ErrorClassTransient
+	}
+
+	return classifyText(err.Error())
+}
+
+// classifyText classifies an error based on well known messages returned by BMCs and ipmitool.
+func classifyText(msg string) ErrorClass {
+	msg = strings.ToLower(msg)
+
+	switch {
+	case strings.Contains(msg, "unauthorized"),
+		strings.Contains(msg, "authentication"),
+		strings.Contains(msg, "invalid user name"),
+		strings.Contains(msg, "rakp"),
+		passwordRejected(msg):
+		return ErrorClassAuth
+	case strings.Contains(msg, "session limit"),
+		strings.Contains(msg, "insufficient resources for session"),
+		strings.Contains(msg, "node busy"),
+		strings.Contains(msg, "service unavailable"):
+		return ErrorClassBusy
+	case strings.Contains(msg, "timeout"),
+		strings.Contains(msg, "timed out"),
+		strings.Contains(msg, "connection refused"),
+		strings.Contains(msg, "connection reset"),
+		strings.Contains(msg, "no route to host"),
+		strings.HasSuffix(msg, ": eof"):
+		return ErrorClassTransient
+	// ipmitool reports rejected credentials only as a failure to establish the session,
+	// the busy and network causes of the same message are ruled out above.
+	case strings.Contains(msg, "unable to establish ipmi v2 / rmcp+ session"),
+		strings.Contains(msg, "unable to establish lan session"):
+		return ErrorClassAuth
+	case strings.Contains(msg, "not supported"),
+		strings.Contains(msg, "unsupported"),
+		strings.Contains(msg, "invalid command"),
+		strings.Contains(msg, "not implemented"):
+		return ErrorClassUnsupported
+	}
+
+	return ErrorClassUnknown
+}
+
+// passwordRejected returns true for messages about a password that was rejected at login,
+// other password messages, like a new password not meeting the password policy, are not auth failures.
+func passwordRejected(msg string) bool {
+	for _, rejected := range []string{
+		"incorrect password",
+		"wrong password",
+		"bad password",
+		"password is incorrect",
+		"username and/or password",
+		"username or password",
+		"user name or password",
+		"password has expired",
+		"password expired",
+		// the Redfish PasswordChangeRequired message
+		"must be changed before access is granted",
+	} {
+		if strings.Contains(msg, rejected) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,70 @@
+package errors
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyText(t *testing.T) {
+	testCases := map[string]struct {
+		msg  string
+		want ErrorClass
+	}{
+		"ipmitool lanplus bad credentials": {
+			msg:  "Error: Unable to establish IPMI v2 / RMCP+ session",
+			want: ErrorClassAuth,
+		},
+		"ipmitool lan bad credentials": {
+			msg:  "Error: Unable to establish LAN session",
+			want: ErrorClassAuth,
+		},
+		"ipmitool unknown user": {
+			msg:  "RAKP 2 message indicates an error : unauthorized name\nError: Unable to establish IPMI v2 / RMCP+ session",
+			want: ErrorClassAuth,
+		},
+		"ipmitool bad password": {
+			msg:  "RAKP 2 HMAC is invalid\nError: Unable to establish IPMI v2 / RMCP+ session",
+			want: ErrorClassAuth,
+		},
+		"ipmitool out of sessions": {
+			msg:  "Error in open session response message : insufficient resources for session\nError: Unable to establish IPMI v2 / RMCP+ session",
+			want: ErrorClassBusy,
+		},
+		"ipmitool unreachable": {
+			msg:  "Error: Unable to establish IPMI v2 / RMCP+ session: i/o timeout",
+			want: ErrorClassTransient,
+		},
+		"ipmitool invalid command": {
+			msg:  "Invalid command\nUnable to get Chassis Power Status",
+			want: ErrorClassUnsupported,
+		},
+		"redfish dell bad credentials": {
+			msg:  `401: {"error":{"@Message.ExtendedInfo":[{"Message":"Unable to complete the operation because an invalid username and/or password is entered, and therefore authentication failed.","MessageId":"IDRAC.2.8.SYS415"}]}}`,
+			want: ErrorClassAuth,
+		},
+		"redfish password change required": {
+			msg:  "The password provided for this account must be changed before access is granted. PATCH the Password property for this account located at the target URI '/redfish/v1/AccountService/Accounts/2' to complete this process.",
+			want: ErrorClassAuth,
+		},
+		"redfish password policy": {
+			msg:  `400: {"error":{"@Message.ExtendedInfo":[{"Message":"The value '******' for the property Password is of a different format than the property can accept.","MessageId":"Base.1.12.PropertyValueFormatError"}]}}`,
+			want: ErrorClassUnknown,
+		},
+		"redfish password complexity": {
+			msg:  "The password does not meet the password policy requirements.",
+			want: ErrorClassUnknown,
+		},
+		"redfish service unavailable": {
+			msg:  "503: Service Unavailable",
+			want: ErrorClassBusy,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Classify(errors.New(tc.msg)))
+		})
+	}
+}
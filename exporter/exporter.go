@@ -0,0 +1,332 @@
+// Package exporter renders System Event Log entries as RFC 5424 syslog messages, JSON lines or CSV
+// with the BMC host metadata attached, and writes them to an io.Writer.
+//
+// The entries are read with the bmclib Client GetSystemEventLogEntries, GetSystemEventLogSince
+// or WatchSystemEventLog methods.
+package exporter
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/metal-toolbox/bmclib"
+	"github.com/metal-toolbox/bmclib/bmc"
+)
+
+// Format is the format the entries are rendered in.
+type Format string
+
+const (
+	// FormatSyslog renders each entry as an RFC 5424 syslog message on its own line.
+	FormatSyslog Format = "syslog"
+	// FormatJSONLines renders each entry as a JSON object on its own line.
+	FormatJSONLines Format = "jsonl"
+	// FormatCSV renders the entries as CSV records, preceded by a header record.
+	FormatCSV Format = "csv"
+)
+
+const (
+	// syslogAppName is the syslog APP-NAME
+	syslogAppName = "bmclib"
+	// syslogMsgID is the syslog MSGID
+	syslogMsgID = "SEL"
+	// syslogFacility is the local0 syslog facility
+	syslogFacility = 16
+	// DefaultSyslogEnterpriseID is the private enterprise number of the structured data IDs when not set
+	// with WithSyslogEnterpriseID, it is the number reserved for documentation use in RFC 5612.
+	DefaultSyslogEnterpriseID = 32473
+	// syslogNilValue is the syslog value of the fields that are not set
+	syslogNilValue = "-"
+)
+
+var (
+	// ErrUnknownFormat is returned by New for formats other than syslog, jsonl and csv.
+	ErrUnknownFormat = errors.New("unknown export format")
+	// ErrEmptyLabelName is returned by New for host labels with an empty name.
+	ErrEmptyLabelName = errors.New("empty host label name")
+)
+
+// syslogSeverities map the entry severities to the syslog severities
+var syslogSeverities = map[bmc.SystemEventLogSeverity]int{
+	bmc.SystemEventLogSeverityCritical: 2,
+	bmc.SystemEventLogSeverityWarning:  4,
+	bmc.SystemEventLogSeverityUnknown:  5,
+	bmc.SystemEventLogSeverityInfo:     6,
+}
+
+// csvHeader are the columns of the CSV records, the host labels follow in the order of their names.
+var csvHeader = []string{"host", "id", "timestamp", "severity", "sensor_type", "sensor_number", "direction", "message"}
+
+// Host is the metadata of the BMC the entries were read from, attached to each exported entry.
+type Host struct {
+	// Name is the BMC hostname or address.
+	Name string
+	// Labels are attached to each entry, like the site or the rack of the machine, the names must not be empty.
+	Labels map[string]string
+}
+
+// Exporter writes System Event Log entries to an io.Writer, it is safe for concurrent use.
+type Exporter struct {
+	mu     sync.Mutex
+	w      io.Writer
+	format Format
+	host   Host
+	// labels are the names of the host labels in order
+	labels []string
+	csv    *csv.Writer
+	// header is set once the CSV header was written
+	header bool
+	// enterpriseID is the private enterprise number of the syslog structured data IDs
+	enterpriseID int
+}
+
+// Option configures the Exporter.
+type Option func(*Exporter)
+
+// WithSyslogEnterpriseID sets the IANA private enterprise number of the syslog structured data IDs,
+// like sel@<id>, defaults to DefaultSyslogEnterpriseID.
+func WithSyslogEnterpriseID(id int) Option {
+	return func(e *Exporter) {
+		e.enterpriseID = id
+	}
+}
+
+// New returns an Exporter writing the entries to w in the given format.
+func New(w io.Writer, format Format, host Host, opts ...Option) (*Exporter, error) {
+	switch format {
+	case FormatSyslog, FormatJSONLines, FormatCSV:
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownFormat, format)
+	}
+
+	labels := make([]string, 0, len(host.Labels))
+	for name := range host.Labels {
+		// an empty name is not a valid syslog structured data parameter name
+		if name == "" {
+			return nil, ErrEmptyLabelName
+		}
+
+		labels = append(labels, name)
+	}
+
+	sort.Strings(labels)
+
+	e := &Exporter{w: w, format: format, host: host, labels: labels, enterpriseID: DefaultSyslogEnterpriseID}
+	for _, opt := range opts {
+		opt(e)
+	}
+
+	if format == FormatCSV {
+		e.csv = csv.NewWriter(w)
+	}
+
+	return e, nil
+}
+
+// Write writes the entry to the io.Writer.
+func (e *Exporter) Write(entry bmc.SystemEventLogEntry) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	switch e.format {
+	case FormatSyslog:
+		_, err := io.WriteString(e.w, e.syslogMessage(entry)+"\n")
+		return err
+	case FormatJSONLines:
+		line, err := e.jsonLine(entry)
+		if err != nil {
+			return err
+		}
+
+		_, err = e.w.Write(append(line, '\n'))
+		return err
+	default:
+		return e.writeCSV(entry)
+	}
+}
+
+// WriteAll writes the entries to the io.Writer, like the entries of a one-off System Event Log read.
+func (e *Exporter) WriteAll(entries []bmc.SystemEventLogEntry) error {
+	for _, entry := range entries {
+		if err := e.Write(entry); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Consume writes the entries received from a System Event Log watch until the channel is closed,
+// it returns early when an entry fails to be written. The events of failed polls are skipped
+// since the watch keeps polling, the onPollError func is called with their error when not nil.
+func (e *Exporter) Consume(events <-chan bmclib.SystemEventLogEvent, onPollError func(error)) error {
+	for event := range events {
+		if event.Err != nil {
+			if onPollError != nil {
+				onPollError(event.Err)
+			}
+
+			continue
+		}
+
+		if err := e.Write(event.Entry); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// syslogMessage returns the entry as an RFC 5424 syslog message, like
+//
+//	<130>1 2024-03-19T10:11:12Z bmc01 bmclib - SEL [sel@32473 id="1" severity="critical"][host@32473 rack="r1"] message
+func (e *Exporter) syslogMessage(entry bmc.SystemEventLogEntry) string {
+	severity, ok := syslogSeverities[entry.Severity]
+	if !ok {
+		severity = syslogSeverities[bmc.SystemEventLogSeverityUnknown]
+	}
+
+	timestamp := syslogNilValue
+	if !entry.Timestamp.IsZero() {
+		timestamp = entry.Timestamp.Format("2006-01-02T15:04:05.999999Z07:00")
+	}
+
+	hostname := syslogNilValue
+	if e.host.Name != "" {
+		hostname = syslogName(e.host.Name, 255)
+	}
+
+	var sd strings.Builder
+	fmt.Fprintf(&sd, "[sel@%d", e.enterpriseID)
+	for _, param := range [][2]string{
+		{"id", entry.ID},
+		{"severity", string(entry.Severity)},
+		{"sensorType", entry.SensorType},
+		{"sensorNumber", entry.SensorNumber},
+		{"direction", string(entry.Direction)},
+	} {
+		if param[1] != "" {
+			fmt.Fprintf(&sd, " %s=\"%s\"", param[0], syslogParamValue(param[1]))
+		}
+	}
+	sd.WriteString("]")
+
+	if len(e.labels) > 0 {
+		fmt.Fprintf(&sd, "[host@%d", e.enterpriseID)
+		for _, name := range e.labels {
+			fmt.Fprintf(&sd, " %s=\"%s\"", syslogName(name, 32), syslogParamValue(e.host.Labels[name]))
+		}
+		sd.WriteString("]")
+	}
+
+	msg := fmt.Sprintf("<%d>1 %s %s %s %s %s %s", syslogFacility*8+severity, timestamp, hostname, syslogAppName, syslogNilValue, syslogMsgID, sd.String())
+	if entry.Message != "" {
+		msg += " " + strings.ReplaceAll(entry.Message, "\n", " ")
+	}
+
+	return msg
+}
+
+// syslogName returns the value with the characters not allowed in the syslog header fields
+// and structured data names replaced by an underscore, truncated to the maximum length.
+func syslogName(value string, maxLen int) string {
+	name := []byte(value)
+	for i, c := range name {
+		if c <= ' ' || c > '~' || c == '=' || c == ']' || c == '"' {
+			name[i] = '_'
+		}
+	}
+
+	if len(name) > maxLen {
+		name = name[:maxLen]
+	}
+
+	return string(name)
+}
+
+// syslogParamValue returns the value with the characters that must be escaped in the structured data escaped.
+func syslogParamValue(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`).Replace(value)
+}
+
+// jsonRecord is the JSON lines representation of an entry
+type jsonRecord struct {
+	Host         string            `json:"host"`
+	Labels       map[string]string `json:"labels,omitempty"`
+	ID           string            `json:"id"`
+	Timestamp    *time.Time        `json:"timestamp,omitempty"`
+	Severity     string            `json:"severity"`
+	SensorType   string            `json:"sensor_type,omitempty"`
+	SensorNumber string            `json:"sensor_number,omitempty"`
+	Direction    string            `json:"direction,omitempty"`
+	Message      string            `json:"message"`
+	Raw          string            `json:"raw,omitempty"`
+}
+
+// jsonLine returns the entry as a JSON object
+func (e *Exporter) jsonLine(entry bmc.SystemEventLogEntry) ([]byte, error) {
+	record := jsonRecord{
+		Host:         e.host.Name,
+		Labels:       e.host.Labels,
+		ID:           entry.ID,
+		Severity:     string(entry.Severity),
+		SensorType:   entry.SensorType,
+		SensorNumber: entry.SensorNumber,
+		Direction:    string(entry.Direction),
+		Message:      entry.Message,
+		Raw:          entry.Raw,
+	}
+
+	if !entry.Timestamp.IsZero() {
+		record.Timestamp = &entry.Timestamp
+	}
+
+	return json.Marshal(record)
+}
+
+// writeCSV writes the entry as a CSV record, preceded by the header for the first entry
+func (e *Exporter) writeCSV(entry bmc.SystemEventLogEntry) error {
+	if !e.header {
+		if err := e.csv.Write(append(append([]string{}, csvHeader...), e.labels...)); err != nil {
+			return err
+		}
+
+		e.header = true
+	}
+
+	var timestamp string
+	if !entry.Timestamp.IsZero() {
+		timestamp = entry.Timestamp.Format(time.RFC3339)
+	}
+
+	record := []string{
+		e.host.Name,
+		entry.ID,
+		timestamp,
+		string(entry.Severity),
+		entry.SensorType,
+		entry.SensorNumber,
+		string(entry.Direction),
+		entry.Message,
+	}
+
+	for _, name := range e.labels {
+		record = append(record, e.host.Labels[name])
+	}
+
+	if err := e.csv.Write(record); err != nil {
+		return err
+	}
+
+	// records are flushed as they are written to stream the entries of a watch
+	e.csv.Flush()
+
+	return e.csv.Error()
+}
@@ -0,0 +1,127 @@
+package exporter
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/metal-toolbox/bmclib"
+	"github.com/metal-toolbox/bmclib/bmc"
+	"github.com/stretchr/testify/assert"
+)
+
+var testEntries = []bmc.SystemEventLogEntry{
+	{
+		ID:           "1",
+		Timestamp:    time.Date(2024, 3, 19, 10, 11, 12, 0, time.UTC),
+		Severity:     bmc.SystemEventLogSeverityCritical,
+		SensorType:   "Temperature",
+		SensorNumber: "0x30",
+		Direction:    bmc.SystemEventAsserted,
+		Message:      "Upper Critical going high",
+		Raw:          "1 | 03/19/2024 | 10:11:12 | Temperature #0x30 | Upper Critical going high | Asserted",
+	},
+	{
+		ID:         "2",
+		Severity:   bmc.SystemEventLogSeverityInfo,
+		SensorType: "System Event",
+		Message:    `Log area reset/cleared "by user"`,
+	},
+}
+
+var testHost = Host{Name: "bmc01.example.com", Labels: map[string]string{"site": "ams1", "rack": "r12"}}
+
+func TestExporter(t *testing.T) {
+	testCases := []struct {
+		format Format
+		want   string
+	}{
+		{
+			format: FormatSyslog,
+			want: `<130>1 2024-03-19T10:11:12Z bmc01.example.com bmclib - SEL [sel@32473 id="1" severity="critical" sensorType="Temperature" sensorNumber="0x30" direction="asserted"][host@32473 rack="r12" site="ams1"] Upper Critical going high
+<134>1 - bmc01.example.com bmclib - SEL [sel@32473 id="2" severity="info" sensorType="System Event"][host@32473 rack="r12" site="ams1"] Log area reset/cleared "by user"
+`,
+		},
+		{
+			format: FormatJSONLines,
+			want: `{"host":"bmc01.example.com","labels":{"rack":"r12","site":"ams1"},"id":"1","timestamp":"2024-03-19T10:11:12Z","severity":"critical","sensor_type":"Temperature","sensor_number":"0x30","direction":"asserted","message":"Upper Critical going high","raw":"1 | 03/19/2024 | 10:11:12 | Temperature #0x30 | Upper Critical going high | Asserted"}
+{"host":"bmc01.example.com","labels":{"rack":"r12","site":"ams1"},"id":"2","severity":"info","sensor_type":"System Event","message":"Log area reset/cleared \"by user\""}
+`,
+		},
+		{
+			format: FormatCSV,
+			want: `host,id,timestamp,severity,sensor_type,sensor_number,direction,message,rack,site
+bmc01.example.com,1,2024-03-19T10:11:12Z,critical,Temperature,0x30,asserted,Upper Critical going high,r12,ams1
+bmc01.example.com,2,,info,System Event,,,"Log area reset/cleared ""by user""",r12,ams1
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.format), func(t *testing.T) {
+			var buf bytes.Buffer
+			e, err := New(&buf, tc.format, testHost)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Nil(t, e.WriteAll(testEntries))
+			assert.Equal(t, tc.want, buf.String())
+		})
+	}
+}
+
+func TestNewUnknownFormat(t *testing.T) {
+	_, err := New(&bytes.Buffer{}, "xml", testHost)
+	assert.True(t, errors.Is(err, ErrUnknownFormat))
+}
+
+func TestNewEmptyLabelName(t *testing.T) {
+	_, err := New(&bytes.Buffer{}, FormatSyslog, Host{Name: "bmc01", Labels: map[string]string{"": "r12"}})
+	assert.True(t, errors.Is(err, ErrEmptyLabelName))
+}
+
+func TestSyslogEnterpriseID(t *testing.T) {
+	e, err := New(&bytes.Buffer{}, FormatSyslog, Host{Name: "bmc01", Labels: map[string]string{"rack": "r12"}}, WithSyslogEnterpriseID(57924))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := e.syslogMessage(bmc.SystemEventLogEntry{ID: "7", Severity: bmc.SystemEventLogSeverityInfo})
+	assert.Equal(t, `<134>1 - bmc01 bmclib - SEL [sel@57924 id="7" severity="info"][host@57924 rack="r12"]`, got)
+}
+
+func TestSyslogEscaping(t *testing.T) {
+	e, err := New(&bytes.Buffer{}, FormatSyslog, Host{Name: "bmc 01", Labels: map[string]string{"row]": `a"b\c]`}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := e.syslogMessage(bmc.SystemEventLogEntry{ID: "7", Severity: "unexpected"})
+	assert.Equal(t, `<133>1 - bmc_01 bmclib - SEL [sel@32473 id="7" severity="unexpected"][host@32473 row_="a\"b\\c\]"]`, got)
+}
+
+func TestConsume(t *testing.T) {
+	events := make(chan bmclib.SystemEventLogEvent, 3)
+	events <- bmclib.SystemEventLogEvent{Entry: testEntries[0]}
+	events <- bmclib.SystemEventLogEvent{Err: errors.New("sel read failed")}
+	events <- bmclib.SystemEventLogEvent{Entry: testEntries[1]}
+	close(events)
+
+	var buf bytes.Buffer
+	e, err := New(&buf, FormatCSV, Host{Name: "bmc01"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var pollErrs []error
+	assert.Nil(t, e.Consume(events, func(err error) { pollErrs = append(pollErrs, err) }))
+	assert.Equal(t, 1, len(pollErrs))
+
+	want := `host,id,timestamp,severity,sensor_type,sensor_number,direction,message
+bmc01,1,2024-03-19T10:11:12Z,critical,Temperature,0x30,asserted,Upper Critical going high
+bmc01,2,,info,System Event,,,"Log area reset/cleared ""by user"""
+`
+	assert.Equal(t, want, buf.String())
+}
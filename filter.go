@@ -2,53 +2,78 @@ package bmclib
 
 import "github.com/jacobweinstock/registrar"
 
-// PreferProvider reorders the registry to have the given provider first.
-// This is a one time/temporary reordering of the providers in the registry.
-// This reorder is not preserved. It is only used for the call that uses the returned Client.
+// scope selects and orders the providers of a registry for a scoped Client view.
+type scope func(registrar.Registry) registrar.Drivers
+
+// PreferProvider returns a view of the Client with the given provider first.
+//
+// The view shares the connections, credentials, metadata and registry of the Client, the reordering only
+// applies to calls made with the returned Client and is kept for as long as it is used.
+// The Client itself is not modified, so views can be created and used concurrently.
 // Update the Client.Registry to make the change permanent. For example, `cl.Registry.Drivers = cl.Registry.PreferDriver("ipmitool")`
 func (c *Client) PreferProvider(name string) *Client {
-	c.oneTimeRegistry.Drivers = c.Registry.PreferDriver(name)
-	c.oneTimeRegistryEnabled = true
-
-	return c
+	return c.withScope(func(r registrar.Registry) registrar.Drivers { return r.PreferDriver(name) })
 }
 
-// Supports removes any provider from the registry that does not support the given features.
-// This is a one time/temporary reordering of the providers in the registry.
-// This reorder is not preserved. It is only used for the call that uses the returned Client.
+// Supports returns a view of the Client without the providers that do not support the given features.
+// Once Capabilities has been called, providers that were probed and found not to support
+// the features on the BMC are excluded as well.
+// The Client itself is not modified, see PreferProvider for details on views.
 func (c *Client) Supports(features ...registrar.Feature) *Client {
-	c.oneTimeRegistry.Drivers = c.Registry.Supports(features...)
-	c.oneTimeRegistryEnabled = true
-
-	return c
+	return c.withScope(func(r registrar.Registry) registrar.Drivers {
+		return c.supportedAtRuntime(r.Supports(features...), features...)
+	})
 }
 
-// Using removes any provider from the registry that does not support the given protocol.
-// This is a one time/temporary reordering of the providers in the registry.
-// This reorder is not preserved. It is only used for the call that uses the returned Client.
+// Using returns a view of the Client without the providers that do not support the given protocol.
+// The Client itself is not modified, see PreferProvider for details on views.
 func (c *Client) Using(protocol string) *Client {
-	c.oneTimeRegistry.Drivers = c.Registry.Using(protocol)
-	c.oneTimeRegistryEnabled = true
-
-	return c
+	return c.withScope(func(r registrar.Registry) registrar.Drivers { return r.Using(protocol) })
 }
 
-// For removes any provider from the registry that is not the given provider.
-// This is a one time/temporary reordering of the providers in the registry.
-// This reorder is not preserved. It is only used for the call that uses the returned Client.
+// For returns a view of the Client with only the given provider.
+// The Client itself is not modified, see PreferProvider for details on views.
 func (c *Client) For(provider string) *Client {
-	c.oneTimeRegistry.Drivers = c.Registry.For(provider)
-	c.oneTimeRegistryEnabled = true
-
-	return c
+	return c.withScope(func(r registrar.Registry) registrar.Drivers { return r.For(provider) })
 }
 
-// PreferProtocol reorders the providers in the registry to have the given protocol first. Matching providers order is preserved.
-// This is a one time/temporary reordering of the providers in the registry.
-// This reorder is not preserved. It is only used for the call that uses the returned Client.
+// PreferProtocol returns a view of the Client with the providers of the given protocols first. Matching providers order is preserved.
+// The Client itself is not modified, see PreferProvider for details on views.
 func (c *Client) PreferProtocol(protocols ...string) *Client {
-	c.oneTimeRegistry.Drivers = c.Registry.PreferProtocol(protocols...)
-	c.oneTimeRegistryEnabled = true
+	return c.withScope(func(r registrar.Registry) registrar.Drivers { return r.PreferProtocol(protocols...) })
+}
+
+// withScope returns a copy of the Client that applies the given scope, in addition to
+// the scopes of the Client, to the shared registry on each method call.
+//
+// Scopes are applied on each call instead of once here so that providers
+// removed from the registry, for example when they fail to Open, are not used by the view.
+func (c *Client) withScope(s scope) *Client {
+	root := c.rootClient()
+
+	// the Auth and Registry fields are updated under the lock when credentials are rotated
+	// or providers are removed, the copy is made under the lock as well.
+	if root.registryLock != nil {
+		root.registryLock.RLock()
+	}
+
+	view := *c
+
+	if root.registryLock != nil {
+		root.registryLock.RUnlock()
+	}
+
+	view.root = root
+	view.scopes = append(append([]scope(nil), c.scopes...), s)
+
+	return &view
+}
+
+// rootClient returns the Client a view was created from, or the Client itself when it is not a view.
+func (c *Client) rootClient() *Client {
+	if c.root != nil {
+		return c.root
+	}
 
 	return c
 }
@@ -0,0 +1,216 @@
+package bmclib
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/metal-toolbox/bmclib/bmc"
+)
+
+// default number of targets a Fleet operates on concurrently
+const defaultFleetConcurrency = 10
+
+// Target identifies a BMC in a Fleet along with its credentials.
+type Target struct {
+	Host string
+	User string
+	Pass string
+	// Options are applied after the options shared by the Fleet, for example to set a provider port for this host.
+	Options []Option
+}
+
+// FleetOperation is called with an open Client for each target in the Fleet.
+type FleetOperation func(ctx context.Context, client *Client) (interface{}, error)
+
+// FleetResult is the outcome of a FleetOperation on a target.
+type FleetResult struct {
+	Host string
+	// Index is the position of the target in the targets the Fleet was created with,
+	// it identifies the target when a host is listed more than once.
+	Index int
+	// Value is the value returned by the FleetOperation.
+	Value interface{}
+	// Err is set when the connection to the BMC could not be opened or the FleetOperation failed.
+	Err error
+	// Metadata is the metadata of the last Client method called, including Open when it failed.
+	Metadata bmc.Metadata
+	// Duration is the time taken to open the connection when required and run the FleetOperation.
+	Duration time.Duration
+}
+
+// FleetReport aggregates the results of a FleetOperation across the Fleet.
+type FleetReport struct {
+	// Results are in the order of the Fleet targets.
+	Results   []FleetResult
+	Succeeded int
+	Failed    int
+}
+
+// Err returns the errors of the failed targets combined, or nil when all targets succeeded.
+func (r FleetReport) Err() error {
+	var err error
+	for _, result := range r.Results {
+		if result.Err != nil {
+			err = multierror.Append(err, &FleetError{Host: result.Host, Err: result.Err})
+		}
+	}
+
+	return err
+}
+
+// FleetError is the error of a FleetOperation on a target.
+type FleetError struct {
+	Host string
+	Err  error
+}
+
+// Error implements the error interface
+func (e *FleetError) Error() string {
+	return e.Host + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *FleetError) Unwrap() error {
+	return e.Err
+}
+
+// Fleet runs Client operations across many BMCs with bounded concurrency.
+//
+// The Client for each target is created and its connection opened on first use,
+// the connection is kept open for subsequent operations until Close is called.
+type Fleet struct {
+	targets     []*fleetTarget
+	opts        []Option
+	concurrency int
+}
+
+// fleetTarget holds the Client of a target once opened.
+type fleetTarget struct {
+	Target
+	mu     sync.Mutex
+	client *Client
+}
+
+// NewFleet returns a Fleet for the given targets, opts are applied to the Client of each target.
+// concurrency limits the number of targets operated on at the same time, defaults to 10 when not greater than zero.
+func NewFleet(targets []Target, concurrency int, opts ...Option) *Fleet {
+	if concurrency <= 0 {
+		concurrency = defaultFleetConcurrency
+	}
+
+	fleet := &Fleet{opts: opts, concurrency: concurrency}
+	for _, t := range targets {
+		fleet.targets = append(fleet.targets, &fleetTarget{Target: t})
+	}
+
+	return fleet
+}
+
+// Run calls the operation for each target and streams the results as they complete,
+// the channel is closed once all targets have been operated on.
+//
+// Targets not yet started when the context is canceled are reported with the context error.
+func (f *Fleet) Run(ctx context.Context, operation FleetOperation) <-chan FleetResult {
+	results := make(chan FleetResult, len(f.targets))
+
+	go func() {
+		defer close(results)
+
+		sem := make(chan struct{}, f.concurrency)
+		var wg sync.WaitGroup
+		for index, target := range f.targets {
+			select {
+			case <-ctx.Done():
+				results <- FleetResult{Host: target.Host, Index: index, Err: ctx.Err()}
+				continue
+			case sem <- struct{}{}:
+			}
+
+			wg.Add(1)
+			go func(index int, target *fleetTarget) {
+				defer wg.Done()
+				defer func() { <-sem }()
+
+				results <- f.run(ctx, index, target, operation)
+			}(index, target)
+		}
+		wg.Wait()
+	}()
+
+	return results
+}
+
+// RunAll calls the operation for each target and returns the aggregated results once all targets have been operated on.
+func (f *Fleet) RunAll(ctx context.Context, operation FleetOperation) FleetReport {
+	report := FleetReport{Results: make([]FleetResult, len(f.targets))}
+	for result := range f.Run(ctx, operation) {
+		if result.Err != nil {
+			report.Failed++
+		} else {
+			report.Succeeded++
+		}
+
+		report.Results[result.Index] = result
+	}
+
+	return report
+}
+
+// Close closes the connections opened by the Fleet.
+func (f *Fleet) Close(ctx context.Context) error {
+	var err error
+	for _, target := range f.targets {
+		target.mu.Lock()
+		if target.client != nil {
+			if closeErr := target.client.Close(ctx); closeErr != nil {
+				err = multierror.Append(err, &FleetError{Host: target.Host, Err: closeErr})
+			}
+
+			target.client = nil
+		}
+		target.mu.Unlock()
+	}
+
+	return err
+}
+
+// run opens the target Client when required and calls the operation.
+func (f *Fleet) run(ctx context.Context, index int, target *fleetTarget, operation FleetOperation) FleetResult {
+	start := time.Now()
+	ctx, metadata := WithCallMetadata(ctx)
+	result := FleetResult{Host: target.Host, Index: index}
+
+	client, err := f.open(ctx, target)
+	if err != nil {
+		result.Err = err
+	} else {
+		result.Value, result.Err = operation(ctx, client)
+	}
+
+	result.Metadata = metadata.Metadata()
+	result.Duration = time.Since(start)
+
+	return result
+}
+
+// open returns the Client of the target, the Client is created and opened on first use.
+func (f *Fleet) open(ctx context.Context, target *fleetTarget) (*Client, error) {
+	target.mu.Lock()
+	defer target.mu.Unlock()
+
+	if target.client != nil {
+		return target.client, nil
+	}
+
+	opts := append(append([]Option{}, f.opts...), target.Options...)
+	client := NewClient(target.Host, target.User, target.Pass, opts...)
+	if err := client.Open(ctx); err != nil {
+		return nil, err
+	}
+
+	target.client = client
+
+	return client, nil
+}
@@ -0,0 +1,114 @@
+package bmclib
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jacobweinstock/registrar"
+	"github.com/stretchr/testify/assert"
+)
+
+func fleetTestTarget(host string, provider *testProvider) Target {
+	registry := registrar.NewRegistry()
+	registry.Register(provider.Name(), provider.Name(), nil, nil, provider)
+
+	return Target{Host: host, Options: []Option{WithRegistry(registry)}}
+}
+
+func TestFleetRunAll(t *testing.T) {
+	targets := []Target{
+		fleetTestTarget("host1", &testProvider{PName: "tester", Powerstate: "on"}),
+		fleetTestTarget("host2", &testProvider{PName: "tester", Err: errors.New("login failed")}),
+		fleetTestTarget("host3", &testProvider{PName: "tester", Powerstate: "off"}),
+	}
+
+	fleet := NewFleet(targets, 2)
+	defer fleet.Close(context.Background())
+
+	report := fleet.RunAll(context.Background(), func(ctx context.Context, client *Client) (interface{}, error) {
+		return client.GetPowerState(ctx)
+	})
+
+	assert.Equal(t, 2, report.Succeeded)
+	assert.Equal(t, 1, report.Failed)
+	assert.Equal(t, 3, len(report.Results))
+
+	assert.Equal(t, "host1", report.Results[0].Host)
+	assert.Equal(t, "on", report.Results[0].Value)
+	assert.Equal(t, "tester", report.Results[0].Metadata.SuccessfulProvider)
+
+	assert.Equal(t, "host2", report.Results[1].Host)
+	assert.NotNil(t, report.Results[1].Err)
+	assert.Contains(t, report.Results[1].Metadata.FailedProviderDetail, "tester")
+
+	assert.Equal(t, "off", report.Results[2].Value)
+
+	var fleetErr *FleetError
+	assert.True(t, errors.As(report.Err(), &fleetErr))
+	assert.Equal(t, "host2", fleetErr.Host)
+}
+
+func TestFleetConcurrency(t *testing.T) {
+	var targets []Target
+	for _, host := range []string{"host1", "host2", "host3", "host4", "host5"} {
+		targets = append(targets, fleetTestTarget(host, &testProvider{PName: "tester", Powerstate: "on"}))
+	}
+
+	fleet := NewFleet(targets, 2)
+	defer fleet.Close(context.Background())
+
+	var running, maxRunning int32
+	operation := func(ctx context.Context, client *Client) (interface{}, error) {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+
+		for {
+			current := atomic.LoadInt32(&maxRunning)
+			if n <= current || atomic.CompareAndSwapInt32(&maxRunning, current, n) {
+				break
+			}
+		}
+
+		time.Sleep(10 * time.Millisecond)
+
+		return nil, nil
+	}
+
+	var count int
+	for result := range fleet.Run(context.Background(), operation) {
+		assert.Nil(t, result.Err)
+		count++
+	}
+
+	assert.Equal(t, 5, count)
+	assert.LessOrEqual(t, maxRunning, int32(2))
+}
+
+func TestFleetRunAllDuplicateHosts(t *testing.T) {
+	targets := []Target{
+		fleetTestTarget("host1", &testProvider{PName: "tester", Powerstate: "on"}),
+		fleetTestTarget("host1", &testProvider{PName: "tester", Powerstate: "off"}),
+	}
+
+	fleet := NewFleet(targets, 2)
+	defer fleet.Close(context.Background())
+
+	// the first target completes last, its result must not be matched to the second target.
+	report := fleet.RunAll(context.Background(), func(ctx context.Context, client *Client) (interface{}, error) {
+		state, err := client.GetPowerState(ctx)
+		if state == "on" {
+			time.Sleep(20 * time.Millisecond)
+		}
+
+		return state, err
+	})
+
+	assert.Equal(t, 2, report.Succeeded)
+	assert.Equal(t, "on", report.Results[0].Value)
+	assert.Equal(t, 0, report.Results[0].Index)
+	assert.Equal(t, "off", report.Results[1].Value)
+	assert.Equal(t, 1, report.Results[1].Index)
+}
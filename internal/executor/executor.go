@@ -9,6 +9,8 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
+
+	bmclibErrs "github.com/metal-toolbox/bmclib/errors"
 )
 
 // Executor interface lets us implement dummy executors for tests
@@ -88,7 +90,11 @@ func (e *Execute) ExecWithContext(ctx context.Context) (result *Result, err erro
 
 	if err := cmd.Run(); err != nil {
 		result = &Result{stdoutBuf.Bytes(), stderrBuf.Bytes(), cmd.ProcessState.ExitCode()}
-		return result, newExecError(e.GetCmd(), result)
+		return result, bmclibErrs.NewExitCodeError(
+			result.ExitCode,
+			string(result.Stderr)+string(result.Stdout),
+			newExecError(e.GetCmd(), result),
+		)
 	}
 
 	result = &Result{stdoutBuf.Bytes(), stderrBuf.Bytes(), cmd.ProcessState.ExitCode()}
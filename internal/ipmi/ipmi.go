@@ -7,12 +7,19 @@ import (
 	"net"
 	"os"
 	"os/exec"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
+
+	bmclibErrs "github.com/metal-toolbox/bmclib/errors"
 )
 
+// ErrUnexpectedOutput is returned when ipmitool succeeds but its output is not the one expected for the command.
+var ErrUnexpectedOutput = errors.New("unexpected ipmitool output")
+
 // Ipmi holds the date for an ipmi connection
 type Ipmi struct {
 	Username    string
@@ -104,7 +111,28 @@ func (i *Ipmi) run(ctx context.Context, command []string) (output string, err er
 		return string(out), ctx.Err()
 	}
 
-	return string(out), errors.Wrap(err, strings.TrimSpace(string(out)))
+	if err == nil {
+		return string(out), nil
+	}
+
+	// the ipmitool exit code and output are included in the error for it to be classified.
+	var exitCode int
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		exitCode = exitErr.ExitCode()
+	}
+
+	return string(out), bmclibErrs.NewExitCodeError(exitCode, string(out), errors.Wrap(err, strings.TrimSpace(string(out))))
+}
+
+// outputErr returns the error of a command whose output didn't match the expected output,
+// the ipmitool error is wrapped when the command failed.
+func outputErr(err error, output string) error {
+	if err != nil {
+		return fmt.Errorf("%w: %v", err, output)
+	}
+
+	return fmt.Errorf("%w: %v", ErrUnexpectedOutput, output)
 }
 
 type cmdOpt struct {
@@ -133,13 +161,13 @@ func formatOptions(opts []string) []cmdOpt {
 func (i *Ipmi) PowerCycle(ctx context.Context) (status bool, err error) {
 	output, err := i.run(ctx, []string{"chassis", "power", "cycle"})
 	if err != nil {
-		return false, fmt.Errorf("%v: %v", err, output)
+		return false, outputErr(err, output)
 	}
 
 	if strings.HasPrefix(output, "Chassis Power Control: Cycle") {
 		return true, err
 	}
-	return false, fmt.Errorf("%v: %v", err, output)
+	return false, outputErr(err, output)
 }
 
 // ForceRestart does the chassis power cycle even if the chassis is turned off.
@@ -149,7 +177,7 @@ func (i *Ipmi) PowerCycle(ctx context.Context) (status bool, err error) {
 func (i *Ipmi) ForceRestart(ctx context.Context) (status bool, err error) {
 	output, err := i.run(ctx, []string{"chassis", "power", "status"})
 	if err != nil {
-		return false, fmt.Errorf("%v: %v", err, output)
+		return false, outputErr(err, output)
 	}
 
 	command := "on"
@@ -158,29 +186,29 @@ func (i *Ipmi) ForceRestart(ctx context.Context) (status bool, err error) {
 		command = "cycle"
 		reply = "Cycle"
 	} else if !strings.HasPrefix(output, "Chassis Power is off") {
-		return false, fmt.Errorf("%v: %v", err, output)
+		return false, outputErr(err, output)
 	}
 
 	output, err = i.run(ctx, []string{"chassis", "power", command})
 	if err != nil {
-		return false, fmt.Errorf("%v: %v", err, output)
+		return false, outputErr(err, output)
 	}
 
 	if strings.HasPrefix(output, "Chassis Power Control: "+reply) {
 		return true, err
 	}
-	return false, fmt.Errorf("%v: %v", err, output)
+	return false, outputErr(err, output)
 }
 
 // PowerReset reboots the machine via bmc
 func (i *Ipmi) PowerReset(ctx context.Context) (status bool, err error) {
 	output, err := i.run(ctx, []string{"chassis", "power", "reset"})
 	if err != nil {
-		return false, fmt.Errorf("%v: %v", err, output)
+		return false, outputErr(err, output)
 	}
 
 	if !strings.HasPrefix(output, "Chassis Power Control: Reset") {
-		return false, fmt.Errorf("%v: %v", err, output)
+		return false, outputErr(err, output)
 	}
 	return true, err
 }
@@ -189,26 +217,26 @@ func (i *Ipmi) PowerReset(ctx context.Context) (status bool, err error) {
 func (i *Ipmi) PowerCycleBmc(ctx context.Context) (status bool, err error) {
 	output, err := i.run(ctx, []string{"mc", "reset", "cold"})
 	if err != nil {
-		return false, fmt.Errorf("%v: %v", err, output)
+		return false, outputErr(err, output)
 	}
 
 	if strings.HasPrefix(output, "Sent cold reset command to MC") {
 		return true, err
 	}
-	return false, fmt.Errorf("%v: %v", err, output)
+	return false, outputErr(err, output)
 }
 
 // PowerResetBmc reboots the bmc we are connected to
 func (i *Ipmi) PowerResetBmc(ctx context.Context, resetType string) (ok bool, err error) {
 	output, err := i.run(ctx, []string{"mc", "reset", strings.ToLower(resetType)})
 	if err != nil {
-		return false, fmt.Errorf("%v: %v", err, output)
+		return false, outputErr(err, output)
 	}
 
 	if strings.HasPrefix(output, fmt.Sprintf("Sent %v reset command to MC", strings.ToLower(resetType))) {
 		return true, err
 	}
-	return false, fmt.Errorf("%v: %v", err, output)
+	return false, outputErr(err, output)
 }
 
 // PowerOn power on the machine via bmc
@@ -223,7 +251,7 @@ func (i *Ipmi) PowerOn(ctx context.Context) (status bool, err error) {
 
 	output, err := i.run(ctx, []string{"chassis", "power", "on"})
 	if err != nil {
-		return false, fmt.Errorf("%v: %v", err, output)
+		return false, outputErr(err, output)
 	}
 
 	if strings.HasPrefix(output, "Chassis Power Control: Up/On") {
@@ -236,13 +264,13 @@ func (i *Ipmi) PowerOn(ctx context.Context) (status bool, err error) {
 func (i *Ipmi) PowerOnForce(ctx context.Context) (status bool, err error) {
 	output, err := i.run(ctx, []string{"chassis", "power", "on"})
 	if err != nil {
-		return false, fmt.Errorf("%v: %v", err, output)
+		return false, outputErr(err, output)
 	}
 
 	if strings.HasPrefix(output, "Chassis Power Control: Up/On") {
 		return true, err
 	}
-	return false, fmt.Errorf("%v: %v", err, output)
+	return false, outputErr(err, output)
 }
 
 // PowerOff power off the machine via bmc
@@ -251,7 +279,7 @@ func (i *Ipmi) PowerOff(ctx context.Context) (status bool, err error) {
 	if strings.Contains(output, "Chassis Power Control: Down/Off") {
 		return true, err
 	}
-	return false, fmt.Errorf("%v: %v", err, output)
+	return false, outputErr(err, output)
 }
 
 // PowerSoft power off the machine via bmc
@@ -263,7 +291,7 @@ func (i *Ipmi) PowerSoft(ctx context.Context) (status bool, err error) {
 
 	output, err := i.run(ctx, []string{"chassis", "power", "soft"})
 	if !strings.Contains(output, "Chassis Power Control: Soft") {
-		return false, fmt.Errorf("%v: %v", err, output)
+		return false, outputErr(err, output)
 	}
 	return true, err
 }
@@ -272,13 +300,13 @@ func (i *Ipmi) PowerSoft(ctx context.Context) (status bool, err error) {
 func (i *Ipmi) PxeOnceEfi(ctx context.Context) (status bool, err error) {
 	output, err := i.run(ctx, []string{"chassis", "bootdev", "pxe", "options=efiboot"})
 	if err != nil {
-		return false, fmt.Errorf("%v: %v", err, output)
+		return false, outputErr(err, output)
 	}
 
 	if strings.Contains(output, "Set Boot Device to pxe") {
 		return true, err
 	}
-	return false, fmt.Errorf("%v: %v", err, output)
+	return false, outputErr(err, output)
 }
 
 // BootDeviceSet sets the next boot device with options
@@ -302,26 +330,26 @@ func (i *Ipmi) BootDeviceSet(ctx context.Context, bootDevice string, setPersiste
 
 	output, err := i.run(ctx, ipmiCmd)
 	if err != nil {
-		return false, fmt.Errorf("%v: %v", err, output)
+		return false, outputErr(err, output)
 	}
 
 	if strings.Contains(output, fmt.Sprintf("Set Boot Device to %v", strings.ToLower(bootDevice))) {
 		return true, err
 	}
-	return false, fmt.Errorf("%v: %v", err, output)
+	return false, outputErr(err, output)
 }
 
 // PxeOnceMbr makes the machine to boot via pxe once using MBR
 func (i *Ipmi) PxeOnceMbr(ctx context.Context) (status bool, err error) {
 	output, err := i.run(ctx, []string{"chassis", "bootdev", "pxe"})
 	if err != nil {
-		return false, fmt.Errorf("%v: %v", err, output)
+		return false, outputErr(err, output)
 	}
 
 	if strings.Contains(output, "Set Boot Device to pxe") {
 		return true, err
 	}
-	return false, fmt.Errorf("%v: %v", err, output)
+	return false, outputErr(err, output)
 }
 
 // PxeOnce makes the machine to boot via pxe once using MBR
@@ -333,7 +361,7 @@ func (i *Ipmi) PxeOnce(ctx context.Context) (status bool, err error) {
 func (i *Ipmi) IsOn(ctx context.Context) (status bool, err error) {
 	output, err := i.run(ctx, []string{"chassis", "power", "status"})
 	if err != nil {
-		return false, fmt.Errorf("%v: %v", err, output)
+		return false, outputErr(err, output)
 	}
 
 	if strings.Contains(output, "Chassis Power is on") {
@@ -384,8 +412,41 @@ func (i *Ipmi) ClearSystemEventLog(ctx context.Context) (err error) {
 	return err
 }
 
-// GetSystemEventLog returns the system event log entries in ID, Timestamp, Description, Message format
-func (i *Ipmi) GetSystemEventLog(ctx context.Context) (entries [][]string, err error) {
+// SystemEventLogEntry holds an entry of the sel list output
+type SystemEventLogEntry struct {
+	ID string
+	// Date and Time are the date and time columns as printed, like 03/19/2024 and 10:11:12,
+	// or Pre-Init and the BMC uptime for the entries logged before the BMC clock was set.
+	Date string
+	Time string
+	// Timestamp is zero for the Pre-Init entries logged before the BMC clock was set.
+	Timestamp time.Time
+	// SensorType is the sensor type, like Temperature or Power Supply.
+	SensorType string
+	// SensorNumber is the sensor number in hex, like 0x30.
+	SensorNumber string
+	Event        string
+	// Direction is Asserted or Deasserted.
+	Direction string
+	// Raw is the sel list line of the entry.
+	Raw string
+}
+
+// Columns returns the entry in the ID, date time, type #number, event : direction column format
+func (e SystemEventLogEntry) Columns() []string {
+	sensor := e.SensorType
+	if e.SensorNumber != "" {
+		sensor += " #" + e.SensorNumber
+	}
+
+	return []string{e.ID, e.Date + " " + e.Time, sensor, e.Event + " : " + e.Direction}
+}
+
+// selTimestampLayout is the layout of the sel list date and time columns
+const selTimestampLayout = "01/02/2006 15:04:05"
+
+// GetSystemEventLog returns the system event log entries
+func (i *Ipmi) GetSystemEventLog(ctx context.Context) (entries []SystemEventLogEntry, err error) {
 	output, err := i.GetSystemEventLogRaw(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "error getting system event log")
@@ -396,23 +457,49 @@ func (i *Ipmi) GetSystemEventLog(ctx context.Context) (entries [][]string, err e
 	return entries, nil
 }
 
-// parseSystemEventLogRaw parses the raw output of the system event log. Helper
-// function for GetSystemEventLog to make testing the parser easier.
-func parseSystemEventLog(raw string) (entries [][]string) {
+// parseSystemEventLog parses the raw output of the system event log, like
+//
+//	1 | 03/19/2024 | 10:11:12 | Temperature #0x30 | Upper Critical going high | Asserted
+//	2 | Pre-Init  |0000000012| System ACPI Power State #0xc0 | S0/G0: working | Asserted
+//
+// Helper function for GetSystemEventLog to make testing the parser easier.
+func parseSystemEventLog(raw string) (entries []SystemEventLogEntry) {
+	entries = []SystemEventLogEntry{}
+
 	scanner := bufio.NewScanner(strings.NewReader(raw))
 	for scanner.Scan() {
 		line := strings.Split(scanner.Text(), "|")
 		if len(line) < 6 {
 			continue
 		}
+		for i := range line {
+			line[i] = strings.TrimSpace(line[i])
+		}
 		if line[0] == "ID" {
 			continue
 		}
-		for i := range line {
-			line[i] = strings.TrimSpace(line[i])
+
+		entry := SystemEventLogEntry{
+			ID:        line[0],
+			Date:      line[1],
+			Time:      line[2],
+			Event:     line[4],
+			Direction: line[5],
+			Raw:       strings.TrimSpace(scanner.Text()),
+		}
+
+		// the BMC clock is not known to be in any particular time zone, it is taken as UTC
+		if ts, err := time.Parse(selTimestampLayout, line[1]+" "+line[2]); err == nil {
+			entry.Timestamp = ts
+		}
+
+		entry.SensorType = line[3]
+		if sensorType, number, found := strings.Cut(line[3], " #"); found {
+			entry.SensorType = sensorType
+			entry.SensorNumber = number
 		}
-		// ID, Timestamp (date time), Description, Message (message : assertion)
-		entries = append(entries, []string{line[0], fmt.Sprintf("%s %s", line[1], line[2]), line[3], fmt.Sprintf("%s : %s", line[4], line[5])})
+
+		entries = append(entries, entry)
 	}
 
 	return entries
@@ -447,3 +534,390 @@ func (i *Ipmi) SendPowerDiag(ctx context.Context) error {
 
 	return err
 }
+
+// McInfo holds the BMC details returned by the mc info command
+type McInfo struct {
+	// Fields holds the key value pairs of the mc info output, like "Firmware Revision" and "Manufacturer Name".
+	Fields map[string]string
+	// AdditionalDeviceSupport lists the devices the BMC supports, like "SEL Device" and "Chassis Device".
+	AdditionalDeviceSupport []string
+}
+
+// McInfo returns the BMC details and the devices it supports
+func (i *Ipmi) McInfo(ctx context.Context) (*McInfo, error) {
+	output, err := i.run(ctx, []string{"mc", "info"})
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting mc info")
+	}
+
+	return parseMcInfo(output), nil
+}
+
+// parseMcInfo parses the output of the mc info command, list values
+// like the Additional Device Support are indented on the lines following their key.
+func parseMcInfo(raw string) *McInfo {
+	info := &McInfo{Fields: map[string]string{}}
+
+	var listKey string
+	scanner := bufio.NewScanner(strings.NewReader(raw))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		key, value, found := strings.Cut(line, ":")
+		if !found || strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t") {
+			if listKey == "Additional Device Support" {
+				info.AdditionalDeviceSupport = append(info.AdditionalDeviceSupport, strings.TrimSpace(line))
+			}
+
+			continue
+		}
+
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		listKey = ""
+		if value == "" {
+			listKey = key
+			continue
+		}
+
+		info.Fields[key] = value
+	}
+
+	return info
+}
+
+// PowerRestorePolicy returns the chassis power restore policy, one of always-on, always-off or previous
+func (i *Ipmi) PowerRestorePolicy(ctx context.Context) (string, error) {
+	output, err := i.run(ctx, []string{"chassis", "status"})
+	if err != nil {
+		return "", errors.Wrap(err, "error getting chassis status")
+	}
+
+	policy, ok := parseColonFields(output)["Power Restore Policy"]
+	if !ok {
+		return "", errors.New("no power restore policy in chassis status")
+	}
+
+	return policy, nil
+}
+
+// SetPowerRestorePolicy sets the chassis power restore policy, one of always-on, always-off or previous
+func (i *Ipmi) SetPowerRestorePolicy(ctx context.Context, policy string) error {
+	_, err := i.run(ctx, []string{"chassis", "policy", policy})
+	return errors.Wrap(err, "error setting chassis power restore policy")
+}
+
+// ChassisIdentify turns the chassis identify LED on for the given number of seconds, up to 255,
+// zero turns it off and force turns it on until it is turned off
+func (i *Ipmi) ChassisIdentify(ctx context.Context, interval string) error {
+	_, err := i.run(ctx, []string{"chassis", "identify", interval})
+	return errors.Wrap(err, "error setting chassis identify")
+}
+
+// ChassisIdentifyState returns the chassis identify state as described by ipmitool chassis status,
+// one of Off, Temporary (timed) On or Indefinite On. Reporting the state is optional in IPMI,
+// an empty state is returned when the BMC doesn't report it.
+func (i *Ipmi) ChassisIdentifyState(ctx context.Context) (string, error) {
+	output, err := i.run(ctx, []string{"chassis", "status"})
+	if err != nil {
+		return "", errors.Wrap(err, "error getting chassis status")
+	}
+
+	return parseColonFields(output)["Chassis Identify State"], nil
+}
+
+// RestartCause returns the system restart cause as described by ipmitool, like "watchdog expired"
+func (i *Ipmi) RestartCause(ctx context.Context) (string, error) {
+	output, err := i.run(ctx, []string{"chassis", "restart_cause"})
+	if err != nil {
+		return "", errors.Wrap(err, "error getting chassis restart cause")
+	}
+
+	cause, ok := parseColonFields(output)["System restart cause"]
+	if !ok {
+		return "", errors.New("no restart cause in chassis restart_cause output")
+	}
+
+	return cause, nil
+}
+
+// PowerOnHours returns the power on hours counter
+func (i *Ipmi) PowerOnHours(ctx context.Context) (int, error) {
+	output, err := i.run(ctx, []string{"chassis", "poh"})
+	if err != nil {
+		return 0, errors.Wrap(err, "error getting chassis power on hours")
+	}
+
+	value, ok := parseColonFields(output)["POH Counter"]
+	if !ok {
+		return 0, errors.New("no POH Counter in chassis poh output")
+	}
+
+	return leadingInt(value)
+}
+
+// PowerReading holds the DCMI power reading statistics, in watts
+type PowerReading struct {
+	Instantaneous int
+	Minimum       int
+	Maximum       int
+	Average       int
+	// SamplingPeriod is the period of the statistics, in seconds.
+	SamplingPeriod int
+}
+
+// PowerLimit holds the DCMI power limit settings
+type PowerLimit struct {
+	// Watts is the configured power limit.
+	Watts int
+	// Active is set when the power limit is enforced by the BMC.
+	Active bool
+}
+
+// PowerReading returns the DCMI power reading
+func (i *Ipmi) PowerReading(ctx context.Context) (*PowerReading, error) {
+	output, err := i.run(ctx, []string{"dcmi", "power", "reading"})
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting dcmi power reading")
+	}
+
+	return parsePowerReading(output)
+}
+
+// PowerLimit returns the DCMI power limit
+func (i *Ipmi) PowerLimit(ctx context.Context) (*PowerLimit, error) {
+	output, err := i.run(ctx, []string{"dcmi", "power", "get_limit"})
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting dcmi power limit")
+	}
+
+	return parsePowerLimit(output)
+}
+
+// SetPowerLimit sets and activates the DCMI power limit, a limit of zero deactivates the power limit
+func (i *Ipmi) SetPowerLimit(ctx context.Context, watts int) error {
+	if watts == 0 {
+		_, err := i.run(ctx, []string{"dcmi", "power", "deactivate"})
+		return errors.Wrap(err, "error deactivating dcmi power limit")
+	}
+
+	_, err := i.run(ctx, []string{"dcmi", "power", "set_limit", "limit", fmt.Sprint(watts)})
+	if err != nil {
+		return errors.Wrap(err, "error setting dcmi power limit")
+	}
+
+	_, err = i.run(ctx, []string{"dcmi", "power", "activate"})
+	return errors.Wrap(err, "error activating dcmi power limit")
+}
+
+// parsePowerReading parses the output of the dcmi power reading command, like
+//
+//	Instantaneous power reading:                   220 Watts
+//	Minimum during sampling period:                 40 Watts
+//	Maximum during sampling period:                360 Watts
+//	Average power reading over sample period:      222 Watts
+//	IPMI timestamp:                           Thu Jan  1 00:00:00 1970
+//	Sampling period:                          00000001 Seconds.
+//	Power reading state is:                   activated
+func parsePowerReading(raw string) (*PowerReading, error) {
+	fields := parseColonFields(raw)
+
+	reading := &PowerReading{}
+	for key, dst := range map[string]*int{
+		"Instantaneous power reading":              &reading.Instantaneous,
+		"Minimum during sampling period":           &reading.Minimum,
+		"Maximum during sampling period":           &reading.Maximum,
+		"Average power reading over sample period": &reading.Average,
+		"Sampling period":                          &reading.SamplingPeriod,
+	} {
+		value, ok := fields[key]
+		if !ok {
+			continue
+		}
+
+		n, err := leadingInt(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error parsing %s", key)
+		}
+
+		*dst = n
+	}
+
+	if _, ok := fields["Instantaneous power reading"]; !ok {
+		return nil, errors.New("no instantaneous power reading in dcmi output")
+	}
+
+	return reading, nil
+}
+
+// parsePowerLimit parses the output of the dcmi power get_limit command, like
+//
+//	Current Limit State: Power Limit Active
+//	Exception actions:   Hard Power Off & Log Event to SEL
+//	Power Limit:         400   Watts
+//	Correction time:     6000 milliseconds
+//	Sampling period:     1 seconds
+func parsePowerLimit(raw string) (*PowerLimit, error) {
+	fields := parseColonFields(raw)
+
+	value, ok := fields["Power Limit"]
+	if !ok {
+		return nil, errors.New("no power limit in dcmi output")
+	}
+
+	watts, err := leadingInt(value)
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing Power Limit")
+	}
+
+	return &PowerLimit{
+		Watts:  watts,
+		Active: strings.EqualFold(fields["Current Limit State"], "Power Limit Active"),
+	}, nil
+}
+
+// SensorReading holds a sensor of the sdr elist output
+type SensorReading struct {
+	Name string
+	// Status is the sensor status code, like ok, nc (non critical), cr (critical), nr (non recoverable) or ns (no reading).
+	Status string
+	// Value is the numeric reading of the sensor, nil for discrete sensors and sensors without a reading.
+	Value *float64
+	// Unit is the unit of the numeric reading, like "degrees C", "RPM" or "Volts".
+	Unit string
+	// Reading is the reading as printed by ipmitool.
+	Reading string
+}
+
+// SensorReadings returns the readings of the sensors in the SDR repository
+func (i *Ipmi) SensorReadings(ctx context.Context) ([]SensorReading, error) {
+	output, err := i.run(ctx, []string{"sdr", "elist"})
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting sdr elist")
+	}
+
+	return parseSensorReadings(output), nil
+}
+
+// parseSensorReadings parses the output of the sdr elist command, like
+//
+//	CPU Temp         | 01h | ok  |  3.1 | 40 degrees C
+//	FAN1             | 41h | ok  | 29.1 | 4200 RPM
+//	PSU1 Status      | C8h | ok  | 10.1 | Presence detected
+//	PSU2 Status      | C9h | ns  | 10.2 | No Reading
+func parseSensorReadings(raw string) []SensorReading {
+	readings := []SensorReading{}
+
+	scanner := bufio.NewScanner(strings.NewReader(raw))
+	for scanner.Scan() {
+		columns := strings.Split(scanner.Text(), "|")
+		if len(columns) != 5 {
+			continue
+		}
+
+		reading := SensorReading{
+			Name:    strings.TrimSpace(columns[0]),
+			Status:  strings.TrimSpace(columns[2]),
+			Reading: strings.TrimSpace(columns[4]),
+		}
+
+		value, unit, found := strings.Cut(reading.Reading, " ")
+		if n, err := strconv.ParseFloat(value, 64); err == nil && found {
+			reading.Value = &n
+			reading.Unit = strings.TrimSpace(unit)
+		}
+
+		readings = append(readings, reading)
+	}
+
+	return readings
+}
+
+// SensorThresholds holds the thresholds of a sensor of the sensor list output,
+// thresholds reported as na are nil.
+type SensorThresholds struct {
+	Name                string
+	LowerNonRecoverable *float64
+	LowerCritical       *float64
+	LowerNonCritical    *float64
+	UpperNonCritical    *float64
+	UpperCritical       *float64
+	UpperNonRecoverable *float64
+}
+
+// SensorThresholds returns the thresholds of the sensors in the SDR repository
+func (i *Ipmi) SensorThresholds(ctx context.Context) ([]SensorThresholds, error) {
+	output, err := i.run(ctx, []string{"sensor", "list"})
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting sensor list")
+	}
+
+	return parseSensorThresholds(output), nil
+}
+
+// parseSensorThresholds parses the output of the sensor list command, the columns following the
+// sensor name, reading, unit and status are the lnr, lcr, lnc, unc, ucr and unr thresholds, like
+//
+//	CPU Temp         | 40.000     | degrees C  | ok    | 0.000     | 0.000     | 5.000     | 85.000    | 90.000    | 95.000
+//	PSU2 Status      | 0x0        | discrete   | 0x0100| na        | na        | na        | na        | na        | na
+func parseSensorThresholds(raw string) []SensorThresholds {
+	sensors := []SensorThresholds{}
+
+	scanner := bufio.NewScanner(strings.NewReader(raw))
+	for scanner.Scan() {
+		columns := strings.Split(scanner.Text(), "|")
+		if len(columns) != 10 {
+			continue
+		}
+
+		threshold := func(column string) *float64 {
+			n, err := strconv.ParseFloat(strings.TrimSpace(column), 64)
+			if err != nil {
+				return nil
+			}
+
+			return &n
+		}
+
+		sensors = append(sensors, SensorThresholds{
+			Name:                strings.TrimSpace(columns[0]),
+			LowerNonRecoverable: threshold(columns[4]),
+			LowerCritical:       threshold(columns[5]),
+			LowerNonCritical:    threshold(columns[6]),
+			UpperNonCritical:    threshold(columns[7]),
+			UpperCritical:       threshold(columns[8]),
+			UpperNonRecoverable: threshold(columns[9]),
+		})
+	}
+
+	return sensors
+}
+
+// parseColonFields returns the key value pairs of "key: value" formatted output.
+func parseColonFields(raw string) map[string]string {
+	fields := map[string]string{}
+
+	scanner := bufio.NewScanner(strings.NewReader(raw))
+	for scanner.Scan() {
+		key, value, found := strings.Cut(scanner.Text(), ":")
+		if !found {
+			continue
+		}
+
+		fields[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+
+	return fields
+}
+
+// leadingInt returns the integer at the start of a value like "220 Watts".
+func leadingInt(value string) (int, error) {
+	fields := strings.Fields(value)
+	if len(fields) == 0 {
+		return 0, errors.New("empty value")
+	}
+
+	return strconv.Atoi(fields[0])
+}
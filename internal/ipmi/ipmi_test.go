@@ -0,0 +1,272 @@
+package ipmi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	bmclibErrs "github.com/metal-toolbox/bmclib/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMcInfo(t *testing.T) {
+	raw := `Device ID                 : 32
+Device Revision           : 1
+Firmware Revision         : 1.71
+IPMI Version              : 2.0
+Manufacturer ID           : 10876
+Manufacturer Name         : Supermicro
+Product ID                : 6929 (0x1b11)
+Product Name              : Unknown (0x1B11)
+Device Available          : yes
+Provides Device SDRs      : no
+Additional Device Support :
+    Sensor Device
+    SDR Repository Device
+    SEL Device
+    FRU Inventory Device
+    IPMB Event Receiver
+    IPMB Event Generator
+    Chassis Device
+Aux Firmware Rev Info     : 
+    0x00
+    0x00
+`
+
+	info := parseMcInfo(raw)
+
+	assert.Equal(t, "1.71", info.Fields["Firmware Revision"])
+	assert.Equal(t, "Supermicro", info.Fields["Manufacturer Name"])
+	assert.Equal(t, "6929 (0x1b11)", info.Fields["Product ID"])
+	assert.Equal(
+		t,
+		[]string{
+			"Sensor Device",
+			"SDR Repository Device",
+			"SEL Device",
+			"FRU Inventory Device",
+			"IPMB Event Receiver",
+			"IPMB Event Generator",
+			"Chassis Device",
+		},
+		info.AdditionalDeviceSupport,
+	)
+}
+
+func TestParsePowerReading(t *testing.T) {
+	raw := `
+    Instantaneous power reading:                   220 Watts
+    Minimum during sampling period:                 40 Watts
+    Maximum during sampling period:                360 Watts
+    Average power reading over sample period:      222 Watts
+    IPMI timestamp:                           Thu Jan  1 00:00:00 1970
+    Sampling period:                          00000001 Seconds.
+    Power reading state is:                   activated
+
+`
+
+	reading, err := parsePowerReading(raw)
+	assert.Nil(t, err)
+	assert.Equal(t, &PowerReading{Instantaneous: 220, Minimum: 40, Maximum: 360, Average: 222, SamplingPeriod: 1}, reading)
+
+	_, err = parsePowerReading("Power reading state is: deactivated")
+	assert.ErrorContains(t, err, "no instantaneous power reading")
+}
+
+func TestParsePowerLimit(t *testing.T) {
+	testCases := []struct {
+		name string
+		raw  string
+		want *PowerLimit
+	}{
+		{
+			name: "active",
+			raw: `
+    Current Limit State: Power Limit Active
+    Exception actions:   Hard Power Off & Log Event to SEL
+    Power Limit:         400   Watts
+    Correction time:     6000 milliseconds
+    Sampling period:     1 seconds
+`,
+			want: &PowerLimit{Watts: 400, Active: true},
+		},
+		{
+			name: "not active",
+			raw: `
+    Current Limit State: No Active Power Limit
+    Exception actions:   Hard Power Off & Log Event to SEL
+    Power Limit:         0   Watts
+    Correction time:     0 milliseconds
+    Sampling period:     0 seconds
+`,
+			want: &PowerLimit{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			limit, err := parsePowerLimit(tc.raw)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.want, limit)
+		})
+	}
+}
+
+func TestParseChassisCounters(t *testing.T) {
+	fields := parseColonFields("System restart cause: watchdog expired\n")
+	assert.Equal(t, "watchdog expired", fields["System restart cause"])
+
+	fields = parseColonFields("POH Counter  : 1234 hours total (51 days, 10 hours)\n")
+	hours, err := leadingInt(fields["POH Counter"])
+	assert.Nil(t, err)
+	assert.Equal(t, 1234, hours)
+}
+
+func TestParseSensorReadings(t *testing.T) {
+	raw := `CPU Temp         | 01h | ok  |  3.1 | 40 degrees C
+FAN1             | 41h | cr  | 29.1 | 360 RPM
+12V              | 30h | ok  |  7.1 | 12.06 Volts
+Watchdog         | 03h | ok  |  0.0 | 0x00
+PSU2 Status      | C9h | ns  | 10.2 | No Reading
+`
+	temp, fan, volts := 40.0, 360.0, 12.06
+
+	want := []SensorReading{
+		{Name: "CPU Temp", Status: "ok", Value: &temp, Unit: "degrees C", Reading: "40 degrees C"},
+		{Name: "FAN1", Status: "cr", Value: &fan, Unit: "RPM", Reading: "360 RPM"},
+		{Name: "12V", Status: "ok", Value: &volts, Unit: "Volts", Reading: "12.06 Volts"},
+		{Name: "Watchdog", Status: "ok", Reading: "0x00"},
+		{Name: "PSU2 Status", Status: "ns", Reading: "No Reading"},
+	}
+
+	assert.Equal(t, want, parseSensorReadings(raw))
+}
+
+func TestParseSensorThresholds(t *testing.T) {
+	raw := `CPU Temp         | 40.000     | degrees C  | ok    | na        | 0.000     | 5.000     | 85.000    | 90.000    | 95.000
+PSU2 Status      | 0x0        | discrete   | 0x0100| na        | na        | na        | na        | na        | na
+`
+	lcr, lnc, unc, ucr, unr := 0.0, 5.0, 85.0, 90.0, 95.0
+
+	want := []SensorThresholds{
+		{
+			Name:                "CPU Temp",
+			LowerCritical:       &lcr,
+			LowerNonCritical:    &lnc,
+			UpperNonCritical:    &unc,
+			UpperCritical:       &ucr,
+			UpperNonRecoverable: &unr,
+		},
+		{Name: "PSU2 Status"},
+	}
+
+	assert.Equal(t, want, parseSensorThresholds(raw))
+}
+
+func TestParseSystemEventLog(t *testing.T) {
+	raw := `   1 | 03/19/2024 | 10:11:12 | Temperature #0x30 | Upper Critical going high | Asserted
+   2 | Pre-Init  |0000000012| System ACPI Power State #0xc0 | S0/G0: working | Asserted
+   3 | 03/19/2024 | 10:15:00 | Temperature #0x30 | Upper Critical going high | Deasserted
+`
+
+	want := []SystemEventLogEntry{
+		{
+			ID:           "1",
+			Date:         "03/19/2024",
+			Time:         "10:11:12",
+			Timestamp:    time.Date(2024, 3, 19, 10, 11, 12, 0, time.UTC),
+			SensorType:   "Temperature",
+			SensorNumber: "0x30",
+			Event:        "Upper Critical going high",
+			Direction:    "Asserted",
+			Raw:          "1 | 03/19/2024 | 10:11:12 | Temperature #0x30 | Upper Critical going high | Asserted",
+		},
+		{
+			ID:           "2",
+			Date:         "Pre-Init",
+			Time:         "0000000012",
+			SensorType:   "System ACPI Power State",
+			SensorNumber: "0xc0",
+			Event:        "S0/G0: working",
+			Direction:    "Asserted",
+			Raw:          "2 | Pre-Init  |0000000012| System ACPI Power State #0xc0 | S0/G0: working | Asserted",
+		},
+		{
+			ID:           "3",
+			Date:         "03/19/2024",
+			Time:         "10:15:00",
+			Timestamp:    time.Date(2024, 3, 19, 10, 15, 0, 0, time.UTC),
+			SensorType:   "Temperature",
+			SensorNumber: "0x30",
+			Event:        "Upper Critical going high",
+			Direction:    "Deasserted",
+			Raw:          "3 | 03/19/2024 | 10:15:00 | Temperature #0x30 | Upper Critical going high | Deasserted",
+		},
+	}
+
+	assert.Equal(t, want, parseSystemEventLog(raw))
+}
+
+func TestSystemEventLogEntryColumns(t *testing.T) {
+	raw := `   1 | 03/19/2024 | 10:11:12 | Temperature #0x30 | Upper Critical going high | Asserted
+   2 | Pre-Init  |0000000012| System ACPI Power State #0xc0 | S0/G0: working | Asserted
+`
+
+	want := [][]string{
+		{"1", "03/19/2024 10:11:12", "Temperature #0x30", "Upper Critical going high : Asserted"},
+		{"2", "Pre-Init 0000000012", "System ACPI Power State #0xc0", "S0/G0: working : Asserted"},
+	}
+
+	var got [][]string
+	for _, entry := range parseSystemEventLog(raw) {
+		got = append(got, entry.Columns())
+	}
+
+	assert.Equal(t, want, got)
+}
+
+// fakeIpmitool returns an Ipmi running a script that prints the output and exits with the exit code
+func fakeIpmitool(t *testing.T, output string, exitCode int) *Ipmi {
+	t.Helper()
+
+	script := filepath.Join(t.TempDir(), "ipmitool")
+	content := fmt.Sprintf("#!/bin/sh\necho '%s'\nexit %d\n", output, exitCode)
+	if err := os.WriteFile(script, []byte(content), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	i, err := New("admin", "secret", "127.0.0.1", WithIpmitoolPath(script), WithCipherSuite("17"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return i
+}
+
+func TestPowerOffOutput(t *testing.T) {
+	ok, err := fakeIpmitool(t, "Chassis Power Control: Down/Off", 0).PowerOff(context.Background())
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	// the command succeeded but its output doesn't match
+	ok, err = fakeIpmitool(t, "Set Chassis Power Control to Down/Off failed: Node busy", 0).PowerOff(context.Background())
+	assert.False(t, ok)
+	assert.True(t, errors.Is(err, ErrUnexpectedOutput))
+	assert.NotContains(t, err.Error(), "%!w")
+	assert.Contains(t, err.Error(), "Node busy")
+
+	// the ipmitool exit code is wrapped
+	ok, err = fakeIpmitool(t, "Error: Unable to establish IPMI v2 / RMCP+ session", 1).PowerOff(context.Background())
+	assert.False(t, ok)
+	assert.False(t, errors.Is(err, ErrUnexpectedOutput))
+
+	var providerErr *bmclibErrs.ProviderError
+	if assert.True(t, errors.As(err, &providerErr)) {
+		assert.Equal(t, 1, providerErr.ExitCode)
+		assert.Equal(t, bmclibErrs.ErrorClassAuth, providerErr.Class)
+	}
+}
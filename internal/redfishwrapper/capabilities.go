@@ -0,0 +1,205 @@
+package redfishwrapper
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/jacobweinstock/registrar"
+	"github.com/metal-toolbox/bmclib/bmc"
+	bmclibErrs "github.com/metal-toolbox/bmclib/errors"
+	"github.com/metal-toolbox/bmclib/providers"
+	"github.com/pkg/errors"
+)
+
+const serviceRootURI = "/redfish/v1/"
+
+// ServiceCapabilities holds the resources and actions discovered on the Redfish service.
+type ServiceCapabilities struct {
+	RedfishVersion string
+	// Links are the names of the resources linked from the service root, like Systems or UpdateService.
+	Links []string
+	// SystemResetTypes are the allowable values of the ComputerSystem Reset action.
+	SystemResetTypes []string
+	// ManagerResetTypes are the allowable values of the Manager Reset action.
+	ManagerResetTypes []string
+	// VirtualMedia is true when a Manager exposes virtual media devices.
+	VirtualMedia bool
+	// Bios is true when the ComputerSystem links to a Bios resource.
+	Bios bool
+}
+
+// featureLinks are the service root links each provider feature depends on.
+var featureLinks = map[registrar.Feature]string{
+	providers.FeaturePowerState:                    "Systems",
+	providers.FeaturePowerSet:                      "Systems",
+	providers.FeatureBootDeviceSet:                 "Systems",
+	providers.FeatureInventoryRead:                 "Systems",
+	providers.FeatureBootProgress:                  "Systems",
+	providers.FeatureGetBiosConfiguration:          "Systems",
+	providers.FeatureSetBiosConfiguration:          "Systems",
+	providers.FeatureResetBiosConfiguration:        "Systems",
+	providers.FeatureUserCreate:                    "AccountService",
+	providers.FeatureUserUpdate:                    "AccountService",
+	providers.FeatureUserDelete:                    "AccountService",
+	providers.FeatureBmcReset:                      "Managers",
+	providers.FeatureVirtualMedia:                  "Managers",
+	providers.FeatureGetSystemEventLog:             "Managers",
+	providers.FeatureGetSystemEventLogRaw:          "Managers",
+	providers.FeatureGetSystemEventLogEntries:      "Managers",
+	providers.FeatureClearSystemEventLog:           "Chassis",
+	providers.FeatureFirmwareInstall:               "UpdateService",
+	providers.FeatureFirmwareUpload:                "UpdateService",
+	providers.FeatureFirmwareInstallUploaded:       "UpdateService",
+	providers.FeatureFirmwareUploadInitiateInstall: "UpdateService",
+	providers.FeatureFirmwareTaskStatus:            "UpdateService",
+}
+
+// ProbeServiceCapabilities discovers the resources linked from the service root,
+// the allowable reset types and the presence of virtual media and BIOS resources.
+func (c *Client) ProbeServiceCapabilities(ctx context.Context) (*ServiceCapabilities, error) {
+	if err := c.SessionActive(); err != nil {
+		return nil, errors.Wrap(bmclibErrs.ErrNotAuthenticated, err.Error())
+	}
+
+	links, err := c.serviceRootLinks()
+	if err != nil {
+		return nil, err
+	}
+
+	caps := &ServiceCapabilities{
+		RedfishVersion: c.client.Service.RedfishVersion,
+		Links:          links,
+	}
+
+	if caps.hasLink("Systems") {
+		systems, err := c.Systems()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, system := range systems {
+			for _, rt := range system.SupportedResetTypes {
+				caps.SystemResetTypes = append(caps.SystemResetTypes, string(rt))
+			}
+
+			bios, err := system.Bios()
+			if err == nil && bios != nil {
+				caps.Bios = true
+			}
+		}
+	}
+
+	if caps.hasLink("Managers") {
+		managers, err := c.Managers(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, manager := range managers {
+			for _, rt := range manager.SupportedResetTypes {
+				caps.ManagerResetTypes = append(caps.ManagerResetTypes, string(rt))
+			}
+
+			media, err := manager.VirtualMedia()
+			if err == nil && len(media) > 0 {
+				caps.VirtualMedia = true
+			}
+		}
+	}
+
+	return caps, nil
+}
+
+// serviceRootLinks returns the names of the resources linked from the service root.
+func (c *Client) serviceRootLinks() ([]string, error) {
+	resp, err := c.Get(serviceRootURI)
+	if err != nil {
+		return nil, providerError(err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseServiceRootLinks(body)
+}
+
+// parseServiceRootLinks returns the names of the properties in the service root that hold a resource link.
+func parseServiceRootLinks(body []byte) ([]string, error) {
+	root := map[string]json.RawMessage{}
+	if err := json.Unmarshal(body, &root); err != nil {
+		return nil, errors.Wrap(err, "error decoding service root")
+	}
+
+	links := []string{}
+	for name, value := range root {
+		link := struct {
+			ODataID string `json:"@odata.id"`
+		}{}
+
+		if strings.HasPrefix(name, "@") || json.Unmarshal(value, &link) != nil || link.ODataID == "" {
+			continue
+		}
+
+		links = append(links, name)
+	}
+
+	sort.Strings(links)
+
+	return links, nil
+}
+
+func (s *ServiceCapabilities) hasLink(name string) bool {
+	for _, link := range s.Links {
+		if link == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Capabilities returns the capabilities of a provider implementing the given features on this Redfish service.
+func (s *ServiceCapabilities) Capabilities(features registrar.Features) bmc.Capabilities {
+	caps := bmc.Capabilities{
+		Details: map[string]string{
+			"RedfishVersion":    s.RedfishVersion,
+			"ServiceRootLinks":  strings.Join(s.Links, ","),
+			"SystemResetTypes":  strings.Join(s.SystemResetTypes, ","),
+			"ManagerResetTypes": strings.Join(s.ManagerResetTypes, ","),
+		},
+	}
+
+	for _, feature := range features {
+		if s.supports(feature) {
+			caps.Features = append(caps.Features, feature)
+			continue
+		}
+
+		caps.Unsupported = append(caps.Unsupported, feature)
+	}
+
+	return caps
+}
+
+func (s *ServiceCapabilities) supports(feature registrar.Feature) bool {
+	if link, ok := featureLinks[feature]; ok && !s.hasLink(link) {
+		return false
+	}
+
+	switch feature {
+	case providers.FeatureVirtualMedia:
+		return s.VirtualMedia
+	case providers.FeatureGetBiosConfiguration,
+		providers.FeatureSetBiosConfiguration,
+		providers.FeatureResetBiosConfiguration:
+		return s.Bios
+	}
+
+	return true
+}
@@ -0,0 +1,52 @@
+package redfishwrapper
+
+import (
+	"testing"
+
+	"github.com/jacobweinstock/registrar"
+	"github.com/metal-toolbox/bmclib/providers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseServiceRootLinks(t *testing.T) {
+	links, err := parseServiceRootLinks(mustReadFile(t, "serviceroot_no_manager.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"AccountService",
+		"CertificateService",
+		"Chassis",
+		"EventService",
+		"JsonSchemas",
+		"Registries",
+		"SessionService",
+		"Systems",
+		"Tasks",
+		"TelemetryService",
+		"UpdateService",
+	}
+
+	assert.Equal(t, expected, links)
+}
+
+func TestServiceCapabilities(t *testing.T) {
+	caps := &ServiceCapabilities{
+		RedfishVersion:   "1.9.0",
+		Links:            []string{"AccountService", "Chassis", "Systems"},
+		SystemResetTypes: []string{"On", "ForceOff"},
+	}
+
+	got := caps.Capabilities(registrar.Features{
+		providers.FeaturePowerSet,
+		providers.FeatureUserCreate,
+		providers.FeatureBmcReset,
+		providers.FeatureVirtualMedia,
+		providers.FeatureGetBiosConfiguration,
+	})
+
+	assert.Equal(t, registrar.Features{providers.FeaturePowerSet, providers.FeatureUserCreate}, got.Features)
+	assert.Equal(t, registrar.Features{providers.FeatureBmcReset, providers.FeatureVirtualMedia, providers.FeatureGetBiosConfiguration}, got.Unsupported)
+	assert.Equal(t, "On,ForceOff", got.Details["SystemResetTypes"])
+}
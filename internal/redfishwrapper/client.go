@@ -154,7 +154,7 @@ func (c *Client) Open(ctx context.Context) error {
 	var err error
 	c.client, err = gofish.Connect(config)
 
-	return err
+	return providerError(err)
 }
 
 func getTimeout(ctx context.Context) time.Duration {
@@ -190,7 +190,7 @@ func (c *Client) SessionActive() error {
 
 	_, err := c.client.GetSession()
 	if err != nil {
-		return err
+		return providerError(err)
 	}
 
 	return nil
@@ -0,0 +1,23 @@
+package redfishwrapper
+
+import (
+	"errors"
+
+	bmclibErrs "github.com/metal-toolbox/bmclib/errors"
+	"github.com/stmcginnis/gofish/common"
+)
+
+// providerError returns the error as a ProviderError carrying the HTTP status code
+// when the error chain includes a redfish error response, other errors are returned as is.
+func providerError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var rfErr *common.Error
+	if errors.As(err, &rfErr) && rfErr.HTTPReturnedStatusCode != 0 {
+		return bmclibErrs.NewHTTPError(rfErr.HTTPReturnedStatusCode, err)
+	}
+
+	return err
+}
@@ -0,0 +1,92 @@
+package redfishwrapper
+
+import (
+	"context"
+	"time"
+
+	"github.com/metal-toolbox/bmclib/bmc"
+	bmclibErrs "github.com/metal-toolbox/bmclib/errors"
+	"github.com/pkg/errors"
+	"github.com/stmcginnis/gofish/common"
+)
+
+// indicatorLEDStates maps the identify states to the IndicatorLED values
+var indicatorLEDStates = map[bmc.IdentifyState]common.IndicatorLED{
+	bmc.IdentifyOff:   common.OffIndicatorLED,
+	bmc.IdentifyOn:    common.LitIndicatorLED,
+	bmc.IdentifyBlink: common.BlinkingIndicatorLED,
+}
+
+// IdentifyGet returns the state of the system identify LED.
+//
+// The deprecated IndicatorLED is read when reported since it tells a lit LED apart from a blinking one,
+// otherwise an active LocationIndicatorActive is reported as IdentifyOn.
+func (c *Client) IdentifyGet(_ context.Context) (bmc.IdentifyState, error) {
+	if err := c.SessionActive(); err != nil {
+		return "", errors.Wrap(bmclibErrs.ErrNotAuthenticated, err.Error())
+	}
+
+	systems, err := c.Systems()
+	if err != nil {
+		return "", err
+	}
+
+	if len(systems) == 0 {
+		return "", bmclibErrs.ErrRedfishNoSystems
+	}
+
+	system := systems[0]
+	if system.IndicatorLED != "" && system.IndicatorLED != common.UnknownIndicatorLED {
+		return bmc.ParseIdentifyState(string(system.IndicatorLED))
+	}
+
+	if system.LocationIndicatorActive {
+		return bmc.IdentifyOn, nil
+	}
+
+	return bmc.IdentifyOff, nil
+}
+
+// IdentifySet sets the state of the system identify LED, through the IndicatorLED when
+// reported by the system and the LocationIndicatorActive property otherwise.
+//
+// Redfish has no means of turning the LED off after a duration, a non zero duration returns an error.
+func (c *Client) IdentifySet(_ context.Context, state bmc.IdentifyState, duration time.Duration) error {
+	if err := c.SessionActive(); err != nil {
+		return errors.Wrap(bmclibErrs.ErrNotAuthenticated, err.Error())
+	}
+
+	if duration > 0 {
+		return errors.Wrap(bmclibErrs.ErrNotImplemented, "timed identify")
+	}
+
+	led, ok := indicatorLEDStates[state]
+	if !ok {
+		return errors.Errorf("unknown identify state: %q", state)
+	}
+
+	systems, err := c.Systems()
+	if err != nil {
+		return err
+	}
+
+	if len(systems) == 0 {
+		return bmclibErrs.ErrRedfishNoSystems
+	}
+
+	for _, system := range systems {
+		system.DisableEtagMatch(c.disableEtagMatch)
+
+		if system.IndicatorLED != "" && system.IndicatorLED != common.UnknownIndicatorLED {
+			system.IndicatorLED = led
+		} else {
+			system.LocationIndicatorActive = state != bmc.IdentifyOff
+		}
+
+		if err := system.Update(); err != nil {
+			return providerError(err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package redfishwrapper
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/metal-toolbox/bmclib/bmc"
+	bmclibErrs "github.com/metal-toolbox/bmclib/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdentify(t *testing.T) {
+	var patched map[string]interface{}
+	systemHandler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := json.Unmarshal(body, &patched); err != nil {
+				t.Fatal(err)
+			}
+
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		_, _ = w.Write(mustReadFile(t, "/dell/system.embedded.1.json"))
+	}
+
+	mux := http.NewServeMux()
+	for endpoint, handler := range map[string]func(http.ResponseWriter, *http.Request){
+		"/redfish/v1/":                          endpointFunc(t, "/dell/serviceroot.json"),
+		"/redfish/v1/Systems":                   endpointFunc(t, "/dell/systems.json"),
+		"/redfish/v1/Systems/System.Embedded.1": systemHandler,
+	} {
+		mux.HandleFunc(endpoint, handler)
+	}
+
+	server := httptest.NewTLSServer(mux)
+	defer server.Close()
+
+	parsedURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	client := NewClient(parsedURL.Hostname(), parsedURL.Port(), "", "", WithBasicAuthEnabled(true))
+
+	err = client.Open(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state, err := client.IdentifyGet(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, bmc.IdentifyOn, state)
+
+	err = client.IdentifySet(ctx, bmc.IdentifyBlink, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"IndicatorLED": "Blinking"}, patched)
+
+	err = client.IdentifySet(ctx, bmc.IdentifyBlink, time.Minute)
+	assert.ErrorIs(t, err, bmclibErrs.ErrNotImplemented)
+}
@@ -0,0 +1,174 @@
+package redfishwrapper
+
+import (
+	"context"
+	"strings"
+
+	"github.com/metal-toolbox/bmclib/bmc"
+	bmclibErrs "github.com/metal-toolbox/bmclib/errors"
+	"github.com/pkg/errors"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// ErrLogServiceNotFound is returned when none of the log services match the given id.
+var ErrLogServiceNotFound = errors.New("log service not found")
+
+// ownedLogService is a LogService along with the resource it belongs to.
+type ownedLogService struct {
+	owner   bmc.LogServiceOwner
+	ownerID string
+	*redfish.LogService
+}
+
+// LogServices returns the LogServices of the system, the managers and the chassis.
+func (c *Client) LogServices(ctx context.Context) ([]bmc.LogServiceInfo, error) {
+	services, err := c.ownedLogServices(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]bmc.LogServiceInfo, 0, len(services))
+	for _, s := range services {
+		infos = append(infos, bmc.LogServiceInfo{
+			ID:        s.ID,
+			Path:      s.ODataID,
+			Name:      s.Name,
+			Owner:     s.owner,
+			OwnerID:   s.ownerID,
+			EntryType: string(s.LogEntryType),
+			Enabled:   s.ServiceEnabled,
+		})
+	}
+
+	return infos, nil
+}
+
+// GetLogServiceEntries returns the entries of the LogService with the given @odata.id, or Id when only one LogService has that Id.
+func (c *Client) GetLogServiceEntries(ctx context.Context, id string) ([]bmc.SystemEventLogEntry, error) {
+	services, err := c.ownedLogServices(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	service, err := selectLogService(services, id)
+	if err != nil {
+		return nil, err
+	}
+
+	lentries, err := service.Entries()
+	if err != nil {
+		return nil, providerError(err)
+	}
+
+	entries := make([]bmc.SystemEventLogEntry, 0, len(lentries))
+	for _, entry := range lentries {
+		entries = append(entries, systemEventLogEntry(service.ODataID, entry))
+	}
+
+	return entries, nil
+}
+
+// ClearLogService clears the LogService with the given @odata.id, or Id when only one LogService has that Id.
+func (c *Client) ClearLogService(ctx context.Context, id string) error {
+	services, err := c.ownedLogServices(ctx)
+	if err != nil {
+		return err
+	}
+
+	service, err := selectLogService(services, id)
+	if err != nil {
+		return err
+	}
+
+	return providerError(service.ClearLog())
+}
+
+// ownedLogServices returns the LogServices of the system, the managers and the chassis, in that order.
+// The owners whose LogServices fail to be listed are logged and skipped, so that the logs of the others can be read.
+func (c *Client) ownedLogServices(ctx context.Context) ([]ownedLogService, error) {
+	if err := c.SessionActive(); err != nil {
+		return nil, errors.Wrap(bmclibErrs.ErrNotAuthenticated, err.Error())
+	}
+
+	var services []ownedLogService
+	add := func(owner bmc.LogServiceOwner, ownerID string, logServices []*redfish.LogService) {
+		for _, s := range logServices {
+			services = append(services, ownedLogService{owner: owner, ownerID: ownerID, LogService: s})
+		}
+	}
+
+	systems, err := c.Systems()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range systems {
+		logServices, err := s.LogServices()
+		if err != nil {
+			c.logger.Error(providerError(err), "skipping log services", "owner", bmc.LogServiceOwnerSystem, "id", s.ID)
+			continue
+		}
+
+		add(bmc.LogServiceOwnerSystem, s.ID, logServices)
+	}
+
+	managers, err := c.Managers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range managers {
+		logServices, err := m.LogServices()
+		if err != nil {
+			c.logger.Error(providerError(err), "skipping log services", "owner", bmc.LogServiceOwnerManager, "id", m.ID)
+			continue
+		}
+
+		add(bmc.LogServiceOwnerManager, m.ID, logServices)
+	}
+
+	chassis, err := c.client.Service.Chassis()
+	if err != nil {
+		return nil, providerError(err)
+	}
+
+	for _, ch := range chassis {
+		logServices, err := ch.LogServices()
+		if err != nil {
+			c.logger.Error(providerError(err), "skipping log services", "owner", bmc.LogServiceOwnerChassis, "id", ch.ID)
+			continue
+		}
+
+		add(bmc.LogServiceOwnerChassis, ch.ID, logServices)
+	}
+
+	return services, nil
+}
+
+// selectLogService returns the LogService with the given @odata.id, or the only LogService with the given Id.
+func selectLogService(services []ownedLogService, id string) (*redfish.LogService, error) {
+	var matched []ownedLogService
+	for _, s := range services {
+		if strings.TrimSuffix(s.ODataID, "/") == strings.TrimSuffix(id, "/") {
+			return s.LogService, nil
+		}
+
+		if s.ID == id {
+			matched = append(matched, s)
+		}
+	}
+
+	switch len(matched) {
+	case 0:
+		return nil, errors.Wrap(ErrLogServiceNotFound, id)
+	case 1:
+		return matched[0].LogService, nil
+	default:
+		paths := make([]string, 0, len(matched))
+		for _, s := range matched {
+			paths = append(paths, s.ODataID)
+		}
+
+		return nil, errors.Errorf("log service id %s is ambiguous, select one of %s", id, strings.Join(paths, ", "))
+	}
+}
@@ -0,0 +1,144 @@
+package redfishwrapper
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/metal-toolbox/bmclib/bmc"
+	"github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectLogService(t *testing.T) {
+	logService := func(owner bmc.LogServiceOwner, path string) ownedLogService {
+		id := path[strings.LastIndex(path, "/")+1:]
+		return ownedLogService{owner: owner, LogService: &redfish.LogService{Entity: common.Entity{ID: id, ODataID: path}}}
+	}
+
+	services := []ownedLogService{
+		logService(bmc.LogServiceOwnerSystem, "/redfish/v1/Systems/system/LogServices/EventLog"),
+		logService(bmc.LogServiceOwnerManager, "/redfish/v1/Managers/bmc/LogServices/Journal"),
+		logService(bmc.LogServiceOwnerManager, "/redfish/v1/Managers/bmc/LogServices/Sel"),
+		logService(bmc.LogServiceOwnerChassis, "/redfish/v1/Chassis/chassis/LogServices/Sel"),
+	}
+
+	testCases := []struct {
+		name     string
+		id       string
+		wantPath string
+		errMsg   string
+	}{
+		{
+			name:     "by id",
+			id:       "EventLog",
+			wantPath: "/redfish/v1/Systems/system/LogServices/EventLog",
+		},
+		{
+			name:     "by path",
+			id:       "/redfish/v1/Chassis/chassis/LogServices/Sel/",
+			wantPath: "/redfish/v1/Chassis/chassis/LogServices/Sel",
+		},
+		{
+			name:   "ambiguous id",
+			id:     "Sel",
+			errMsg: "log service id Sel is ambiguous",
+		},
+		{
+			name:   "not found",
+			id:     "Lclog",
+			errMsg: ErrLogServiceNotFound.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := selectLogService(services, tc.id)
+			if tc.errMsg != "" {
+				assert.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.wantPath, got.ODataID)
+		})
+	}
+
+	_, err := selectLogService(services, "Lclog")
+	assert.True(t, errors.Is(err, ErrLogServiceNotFound))
+}
+
+func TestLogServicesSkipsFailingOwners(t *testing.T) {
+	jsonFunc := func(body string) func(http.ResponseWriter, *http.Request) {
+		return func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(body))
+		}
+	}
+
+	mux := http.NewServeMux()
+	for endpoint, handler := range map[string]func(http.ResponseWriter, *http.Request){
+		"/redfish/v1/":                          endpointFunc(t, "/dell/serviceroot.json"),
+		"/redfish/v1/Systems":                   endpointFunc(t, "/dell/systems.json"),
+		"/redfish/v1/Systems/System.Embedded.1": endpointFunc(t, "/dell/system.embedded.1.json"),
+		"/redfish/v1/Managers":                  jsonFunc(`{"Members": [{"@odata.id": "/redfish/v1/Managers/iDRAC.Embedded.1"}]}`),
+		"/redfish/v1/Managers/iDRAC.Embedded.1": jsonFunc(`{
+			"@odata.id": "/redfish/v1/Managers/iDRAC.Embedded.1",
+			"Id": "iDRAC.Embedded.1",
+			"LogServices": {"@odata.id": "/redfish/v1/Managers/iDRAC.Embedded.1/LogServices"}
+		}`),
+		"/redfish/v1/Managers/iDRAC.Embedded.1/LogServices": jsonFunc(`{
+			"Members": [{"@odata.id": "/redfish/v1/Managers/iDRAC.Embedded.1/LogServices/Sel"}]
+		}`),
+		"/redfish/v1/Managers/iDRAC.Embedded.1/LogServices/Sel": jsonFunc(`{
+			"@odata.id": "/redfish/v1/Managers/iDRAC.Embedded.1/LogServices/Sel",
+			"Id": "Sel",
+			"LogEntryType": "SEL",
+			"ServiceEnabled": true
+		}`),
+		"/redfish/v1/Chassis": endpointFunc(t, "/dell/chassis.json"),
+		"/redfish/v1/Chassis/System.Embedded.1": jsonFunc(`{
+			"@odata.id": "/redfish/v1/Chassis/System.Embedded.1",
+			"Id": "System.Embedded.1",
+			"LogServices": {"@odata.id": "/redfish/v1/Chassis/System.Embedded.1/LogServices"}
+		}`),
+		// the chassis log services fail to be listed
+		"/redfish/v1/Chassis/System.Embedded.1/LogServices": func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		},
+	} {
+		mux.HandleFunc(endpoint, handler)
+	}
+
+	server := httptest.NewTLSServer(mux)
+	defer server.Close()
+
+	parsedURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	client := NewClient(parsedURL.Hostname(), parsedURL.Port(), "", "", WithBasicAuthEnabled(true))
+
+	if err := client.Open(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	services, err := client.LogServices(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []bmc.LogServiceInfo{
+		{
+			ID:        "Sel",
+			Path:      "/redfish/v1/Managers/iDRAC.Embedded.1/LogServices/Sel",
+			Owner:     bmc.LogServiceOwnerManager,
+			OwnerID:   "iDRAC.Embedded.1",
+			EntryType: "SEL",
+			Enabled:   true,
+		},
+	}, services)
+}
@@ -44,7 +44,7 @@ func (c *Client) BMCReset(ctx context.Context, resetType string) (ok bool, err e
 	for _, manager := range managers {
 		err = manager.Reset(rf.ResetType(resetType))
 		if err != nil {
-			return false, err
+			return false, providerError(err)
 		}
 	}
 
@@ -71,7 +71,7 @@ func (c *Client) SystemPowerOn(ctx context.Context) (ok bool, err error) {
 
 		err = system.Reset(rf.OnResetType)
 		if err != nil {
-			return false, err
+			return false, providerError(err)
 		}
 	}
 	return true, nil
@@ -97,7 +97,7 @@ func (c *Client) SystemPowerOff(ctx context.Context) (ok bool, err error) {
 
 		err = system.Reset(rf.GracefulShutdownResetType)
 		if err != nil {
-			return false, err
+			return false, providerError(err)
 		}
 	}
 
@@ -163,7 +163,7 @@ func (c *Client) SystemPowerCycle(ctx context.Context) (ok bool, err error) {
 
 		err = system.Reset(rf.ForceRestartResetType)
 		if err != nil {
-			return false, errors.WithMessage(err, "power cycle failed")
+			return false, errors.WithMessage(providerError(err), "power cycle failed")
 		}
 	}
 
@@ -208,7 +208,7 @@ func (c *Client) SystemForceOff(ctx context.Context) (ok bool, err error) {
 
 		err = system.Reset(rf.ForceOffResetType)
 		if err != nil {
-			return false, err
+			return false, providerError(err)
 		}
 	}
 
@@ -228,7 +228,7 @@ func (c *Client) SendNMI(_ context.Context) error {
 
 	for _, system := range ss {
 		if err = system.Reset(rf.NmiResetType); err != nil {
-			return err
+			return providerError(err)
 		}
 	}
 
@@ -0,0 +1,146 @@
+package redfishwrapper
+
+import (
+	"context"
+	"time"
+
+	"github.com/metal-toolbox/bmclib/bmc"
+	bmclibErrs "github.com/metal-toolbox/bmclib/errors"
+	"github.com/pkg/errors"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// ErrNoPowerControl is returned when none of the chassis report their power consumption.
+var ErrNoPowerControl = errors.New("no chassis reports power consumption")
+
+// PowerRead returns the power consumption and power limit of the first chassis that reports them.
+//
+// The PowerControl of the Chassis Power resource is read first, services that have
+// replaced it with the PowerSubsystem report the power consumption in the Chassis EnvironmentMetrics.
+func (c *Client) PowerRead(ctx context.Context) (bmc.PowerReading, error) {
+	if err := c.SessionActive(); err != nil {
+		return bmc.PowerReading{}, errors.Wrap(bmclibErrs.ErrNotAuthenticated, err.Error())
+	}
+
+	chassis, err := c.client.Service.Chassis()
+	if err != nil {
+		return bmc.PowerReading{}, providerError(err)
+	}
+
+	for _, ch := range chassis {
+		power, err := ch.Power()
+		if err != nil {
+			return bmc.PowerReading{}, providerError(err)
+		}
+
+		if power != nil && len(power.PowerControl) > 0 {
+			return powerControlReading(&power.PowerControl[0]), nil
+		}
+
+		metrics, err := ch.EnvironmentMetrics()
+		if err != nil {
+			return bmc.PowerReading{}, providerError(err)
+		}
+
+		if reportsPower(metrics) {
+			return environmentMetricsReading(metrics), nil
+		}
+	}
+
+	return bmc.PowerReading{}, ErrNoPowerControl
+}
+
+// PowerCapSet sets the power limit of the first chassis that reports its power consumption,
+// a limit of zero removes the power limit.
+func (c *Client) PowerCapSet(ctx context.Context, watts int) error {
+	if err := c.SessionActive(); err != nil {
+		return errors.Wrap(bmclibErrs.ErrNotAuthenticated, err.Error())
+	}
+
+	chassis, err := c.client.Service.Chassis()
+	if err != nil {
+		return providerError(err)
+	}
+
+	for _, ch := range chassis {
+		power, err := ch.Power()
+		if err != nil {
+			return providerError(err)
+		}
+
+		if power != nil && len(power.PowerControl) > 0 {
+			// a null LimitInWatts disables power capping
+			var limit interface{}
+			if watts > 0 {
+				limit = watts
+			}
+
+			payload := map[string]interface{}{
+				"PowerControl": []map[string]interface{}{
+					{"PowerLimit": map[string]interface{}{"LimitInWatts": limit}},
+				},
+			}
+
+			return c.patch(power.ODataID, payload)
+		}
+
+		metrics, err := ch.EnvironmentMetrics()
+		if err != nil {
+			return providerError(err)
+		}
+
+		if reportsPower(metrics) {
+			if metrics.PowerLimitWatts.DataSourceURI == "" {
+				return errors.Wrap(bmclibErrs.ErrNotImplemented, "chassis environment metrics has no power limit control")
+			}
+
+			payload := map[string]interface{}{"ControlMode": redfish.DisabledControlMode}
+			if watts > 0 {
+				payload = map[string]interface{}{"ControlMode": redfish.AutomaticControlMode, "SetPoint": watts}
+			}
+
+			return c.patch(metrics.PowerLimitWatts.DataSourceURI, payload)
+		}
+	}
+
+	return ErrNoPowerControl
+}
+
+func (c *Client) patch(url string, payload interface{}) error {
+	resp, err := c.client.Patch(url, payload)
+	if err != nil {
+		return providerError(err)
+	}
+
+	return resp.Body.Close()
+}
+
+// reportsPower returns true when the environment metrics include the power consumption,
+// the excerpt has no DataSourceURI when it is the only source of the reading.
+func reportsPower(metrics *redfish.EnvironmentMetrics) bool {
+	return metrics != nil && (metrics.PowerWatts.DataSourceURI != "" || metrics.PowerWatts.Reading > 0)
+}
+
+func powerControlReading(control *redfish.PowerControl) bmc.PowerReading {
+	return bmc.PowerReading{
+		Watts:        float64(control.PowerConsumedWatts),
+		MinWatts:     float64(control.PowerMetrics.MinConsumedWatts),
+		MaxWatts:     float64(control.PowerMetrics.MaxConsumedWatts),
+		AverageWatts: float64(control.PowerMetrics.AverageConsumedWatts),
+		Interval:     time.Duration(float64(control.PowerMetrics.IntervalInMin) * float64(time.Minute)),
+		CapWatts:     float64(control.PowerLimit.LimitInWatts),
+		CapEnabled:   control.PowerLimit.LimitInWatts > 0,
+	}
+}
+
+func environmentMetricsReading(metrics *redfish.EnvironmentMetrics) bmc.PowerReading {
+	reading := bmc.PowerReading{Watts: float64(metrics.PowerWatts.Reading)}
+
+	limit := metrics.PowerLimitWatts
+	if limit.DataSourceURI != "" {
+		reading.CapWatts = limit.SetPoint
+		reading.CapEnabled = limit.SetPoint > 0 && limit.ControlMode != redfish.DisabledControlMode
+	}
+
+	return reading
+}
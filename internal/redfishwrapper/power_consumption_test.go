@@ -0,0 +1,104 @@
+package redfishwrapper
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/metal-toolbox/bmclib/bmc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPowerConsumption(t *testing.T) {
+	var patched map[string]interface{}
+	powerHandler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := json.Unmarshal(body, &patched); err != nil {
+				t.Fatal(err)
+			}
+
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		_, _ = w.Write(mustReadFile(t, "/dell/chassis.system.embedded.1.power.json"))
+	}
+
+	mux := http.NewServeMux()
+	for endpoint, handler := range map[string]func(http.ResponseWriter, *http.Request){
+		"/redfish/v1/":                                endpointFunc(t, "/dell/serviceroot.json"),
+		"/redfish/v1/Chassis":                         endpointFunc(t, "/dell/chassis.json"),
+		"/redfish/v1/Chassis/System.Embedded.1":       endpointFunc(t, "/dell/chassis.system.embedded.1.json"),
+		"/redfish/v1/Chassis/System.Embedded.1/Power": powerHandler,
+		"/redfish/v1/Systems":                         endpointFunc(t, "/dell/systems.json"),
+		"/redfish/v1/Systems/System.Embedded.1":       endpointFunc(t, "/dell/system.embedded.1.json"),
+	} {
+		mux.HandleFunc(endpoint, handler)
+	}
+
+	server := httptest.NewTLSServer(mux)
+	defer server.Close()
+
+	parsedURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	client := NewClient(parsedURL.Hostname(), parsedURL.Port(), "", "", WithBasicAuthEnabled(true))
+
+	err = client.Open(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reading, err := client.PowerRead(ctx)
+	assert.Nil(t, err)
+	assert.Equal(
+		t,
+		bmc.PowerReading{
+			Watts:        168,
+			MinWatts:     166,
+			MaxWatts:     189,
+			AverageWatts: 171,
+			Interval:     time.Minute,
+			CapWatts:     500,
+			CapEnabled:   true,
+		},
+		reading,
+	)
+
+	err = client.PowerCapSet(ctx, 400)
+	assert.Nil(t, err)
+	assert.Equal(
+		t,
+		map[string]interface{}{
+			"PowerControl": []interface{}{
+				map[string]interface{}{"PowerLimit": map[string]interface{}{"LimitInWatts": float64(400)}},
+			},
+		},
+		patched,
+	)
+
+	err = client.PowerCapSet(ctx, 0)
+	assert.Nil(t, err)
+	assert.Equal(
+		t,
+		map[string]interface{}{
+			"PowerControl": []interface{}{
+				map[string]interface{}{"PowerLimit": map[string]interface{}{"LimitInWatts": nil}},
+			},
+		},
+		patched,
+	)
+}
@@ -0,0 +1,60 @@
+package redfishwrapper
+
+import (
+	"context"
+
+	"github.com/metal-toolbox/bmclib/bmc"
+	bmclibErrs "github.com/metal-toolbox/bmclib/errors"
+	"github.com/pkg/errors"
+	rf "github.com/stmcginnis/gofish/redfish"
+)
+
+// PowerRestorePolicyGet returns the PowerRestorePolicy of the system.
+func (c *Client) PowerRestorePolicyGet(_ context.Context) (bmc.PowerRestorePolicy, error) {
+	if err := c.SessionActive(); err != nil {
+		return "", errors.Wrap(bmclibErrs.ErrNotAuthenticated, err.Error())
+	}
+
+	systems, err := c.Systems()
+	if err != nil {
+		return "", err
+	}
+
+	for _, system := range systems {
+		// gofish types the PowerRestorePolicy as a PowerState
+		if system.PowerRestorePolicy == "" {
+			continue
+		}
+
+		return bmc.ParsePowerRestorePolicy(string(system.PowerRestorePolicy))
+	}
+
+	return "", errors.Wrap(bmclibErrs.ErrNotImplemented, "no system reports a PowerRestorePolicy")
+}
+
+// PowerRestorePolicySet sets the PowerRestorePolicy of the systems.
+func (c *Client) PowerRestorePolicySet(_ context.Context, policy bmc.PowerRestorePolicy) error {
+	if err := c.SessionActive(); err != nil {
+		return errors.Wrap(bmclibErrs.ErrNotAuthenticated, err.Error())
+	}
+
+	systems, err := c.Systems()
+	if err != nil {
+		return err
+	}
+
+	if len(systems) == 0 {
+		return bmclibErrs.ErrRedfishNoSystems
+	}
+
+	for _, system := range systems {
+		system.DisableEtagMatch(c.disableEtagMatch)
+		system.PowerRestorePolicy = rf.PowerState(policy)
+
+		if err := system.Update(); err != nil {
+			return providerError(err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package redfishwrapper
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/metal-toolbox/bmclib/bmc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPowerRestorePolicy(t *testing.T) {
+	var patched map[string]interface{}
+	systemHandler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := json.Unmarshal(body, &patched); err != nil {
+				t.Fatal(err)
+			}
+
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		system := map[string]interface{}{}
+		if err := json.Unmarshal(mustReadFile(t, "/dell/system.embedded.1.json"), &system); err != nil {
+			t.Fatal(err)
+		}
+
+		system["PowerRestorePolicy"] = "LastState"
+
+		b, err := json.Marshal(system)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, _ = w.Write(b)
+	}
+
+	mux := http.NewServeMux()
+	for endpoint, handler := range map[string]func(http.ResponseWriter, *http.Request){
+		"/redfish/v1/":                          endpointFunc(t, "/dell/serviceroot.json"),
+		"/redfish/v1/Systems":                   endpointFunc(t, "/dell/systems.json"),
+		"/redfish/v1/Systems/System.Embedded.1": systemHandler,
+	} {
+		mux.HandleFunc(endpoint, handler)
+	}
+
+	server := httptest.NewTLSServer(mux)
+	defer server.Close()
+
+	parsedURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	client := NewClient(parsedURL.Hostname(), parsedURL.Port(), "", "", WithBasicAuthEnabled(true))
+
+	err = client.Open(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	policy, err := client.PowerRestorePolicyGet(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, bmc.PowerRestoreLastState, policy)
+
+	err = client.PowerRestorePolicySet(ctx, bmc.PowerRestoreAlwaysOn)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"PowerRestorePolicy": "AlwaysOn"}, patched)
+}
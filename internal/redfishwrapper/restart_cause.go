@@ -0,0 +1,154 @@
+package redfishwrapper
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/metal-toolbox/bmclib/bmc"
+	bmclibErrs "github.com/metal-toolbox/bmclib/errors"
+	"github.com/pkg/errors"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+const (
+	// resetLogSlack allows for reset log entries created shortly after the LastResetTime.
+	resetLogSlack = time.Minute
+	// resetLogWindow bounds how long before the LastResetTime a reset log entry may be created,
+	// older entries describe earlier restarts.
+	resetLogWindow = 10 * time.Minute
+)
+
+// resetLogPatterns match the Message or MessageId of log entries describing a restart,
+// the text is lower cased with the spaces, dashes and underscores removed before matching,
+// so that both "Power button pressed" and "PowerButtonPressed" match.
+var resetLogPatterns = []struct {
+	pattern string
+	cause   bmc.RestartCause
+}{
+	{"watchdog", bmc.RestartCauseWatchdog},
+	{"resetbutton", bmc.RestartCauseResetButton},
+	{"powerbutton", bmc.RestartCausePowerButton},
+	{"aclost", bmc.RestartCausePowerRestore},
+	{"acpowerlost", bmc.RestartCausePowerRestore},
+	{"powerloss", bmc.RestartCausePowerRestore},
+	{"powerrestore", bmc.RestartCausePowerRestore},
+	{"ctrlaltdel", bmc.RestartCauseSoftReset},
+	{"softreset", bmc.RestartCauseSoftReset},
+}
+
+// LastRestart returns the LastResetTime of the system, with the cause taken from the most recent
+// reset related entry in the system and manager log services logged shortly before the reset.
+func (c *Client) LastRestart(ctx context.Context) (bmc.RestartInfo, error) {
+	if err := c.SessionActive(); err != nil {
+		return bmc.RestartInfo{}, errors.Wrap(bmclibErrs.ErrNotAuthenticated, err.Error())
+	}
+
+	systems, err := c.Systems()
+	if err != nil {
+		return bmc.RestartInfo{}, err
+	}
+
+	if len(systems) == 0 {
+		return bmc.RestartInfo{}, bmclibErrs.ErrRedfishNoSystems
+	}
+
+	// without the reset time the log entries can't be tied to the last restart
+	if systems[0].LastResetTime == "" {
+		return bmc.RestartInfo{}, errors.Wrap(bmclibErrs.ErrNotImplemented, "no LastResetTime")
+	}
+
+	lastReset, err := time.Parse(time.RFC3339, systems[0].LastResetTime)
+	if err != nil {
+		return bmc.RestartInfo{}, errors.Wrap(err, "invalid LastResetTime")
+	}
+
+	entries, err := c.resetLogEntries(ctx, systems[0])
+	if err != nil {
+		return bmc.RestartInfo{}, err
+	}
+
+	return lastRestartFromEntries(lastReset, entries), nil
+}
+
+// resetLogEntries returns the entries of the system and manager log services.
+func (c *Client) resetLogEntries(ctx context.Context, system *redfish.ComputerSystem) ([]*redfish.LogEntry, error) {
+	logServices, err := system.LogServices()
+	if err != nil {
+		return nil, providerError(err)
+	}
+
+	managers, err := c.Managers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range managers {
+		managerLogServices, err := m.LogServices()
+		if err != nil {
+			return nil, providerError(err)
+		}
+
+		logServices = append(logServices, managerLogServices...)
+	}
+
+	var entries []*redfish.LogEntry
+	for _, logService := range logServices {
+		lentries, err := logService.Entries()
+		if err != nil {
+			return nil, providerError(err)
+		}
+
+		entries = append(entries, lentries...)
+	}
+
+	return entries, nil
+}
+
+// lastRestartFromEntries returns the restart info for the given reset time, the cause is taken from
+// the most recent reset related entry logged within the resetLogWindow before the reset.
+// The cause is unknown when the reset time is not known or no entry was logged within the window.
+func lastRestartFromEntries(lastReset time.Time, entries []*redfish.LogEntry) bmc.RestartInfo {
+	info := bmc.RestartInfo{Cause: bmc.RestartCauseUnknown, Time: lastReset}
+	if lastReset.IsZero() {
+		return info
+	}
+
+	var latest time.Time
+	for _, entry := range entries {
+		cause := restartCauseFromLogEntry(entry)
+		if cause == "" {
+			continue
+		}
+
+		created, err := time.Parse(time.RFC3339, entry.Created)
+		if err != nil {
+			continue
+		}
+
+		if created.Before(lastReset.Add(-resetLogWindow)) || created.After(lastReset.Add(resetLogSlack)) {
+			continue
+		}
+
+		if created.After(latest) {
+			latest = created
+			info.Cause = cause
+			info.Detail = entry.Message
+		}
+	}
+
+	return info
+}
+
+// restartCauseFromLogEntry returns the restart cause the log entry describes, or an empty cause.
+func restartCauseFromLogEntry(entry *redfish.LogEntry) bmc.RestartCause {
+	text := strings.NewReplacer(" ", "", "-", "", "_", "").Replace(strings.ToLower(entry.Message + entry.MessageID))
+
+	for _, p := range resetLogPatterns {
+		if strings.Contains(text, p.pattern) {
+			return p.cause
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,63 @@
+package redfishwrapper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/metal-toolbox/bmclib/bmc"
+	"github.com/stmcginnis/gofish/redfish"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLastRestartFromEntries(t *testing.T) {
+	lastReset := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	entries := []*redfish.LogEntry{
+		{Created: "2024-03-01T08:00:00Z", Message: "Power button pressed"},
+		{Created: "2024-03-01T09:59:30Z", MessageID: "OpenBMC.0.1.ResetButtonPressed", Message: "Reset Button Pressed"},
+		{Created: "2024-03-01T09:59:50Z", Message: "The fan speed is within range"},
+		// logged after the reset, describes a later event
+		{Created: "2024-03-01T11:00:00Z", Message: "The watchdog timer expired"},
+	}
+
+	testCases := []struct {
+		name      string
+		lastReset time.Time
+		entries   []*redfish.LogEntry
+		want      bmc.RestartInfo
+	}{
+		{
+			name:      "entry before reset",
+			lastReset: lastReset,
+			entries:   entries,
+			want:      bmc.RestartInfo{Cause: bmc.RestartCauseResetButton, Detail: "Reset Button Pressed", Time: lastReset},
+		},
+		{
+			name:    "no reset time",
+			entries: entries,
+			want:    bmc.RestartInfo{Cause: bmc.RestartCauseUnknown},
+		},
+		{
+			name:      "stale entry",
+			lastReset: lastReset,
+			entries: []*redfish.LogEntry{
+				// logged by an earlier restart months before
+				{Created: "2023-11-20T04:12:00Z", Message: "The watchdog timer expired"},
+				{Created: "2024-03-01T09:59:50Z", Message: "The fan speed is within range"},
+			},
+			want: bmc.RestartInfo{Cause: bmc.RestartCauseUnknown, Time: lastReset},
+		},
+		{
+			name:      "no reset entries",
+			lastReset: lastReset,
+			entries:   entries[2:3],
+			want:      bmc.RestartInfo{Cause: bmc.RestartCauseUnknown, Time: lastReset},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, lastRestartFromEntries(tc.lastReset, tc.entries))
+		})
+	}
+}
@@ -3,13 +3,17 @@ package redfishwrapper
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"time"
 
+	"github.com/metal-toolbox/bmclib/bmc"
 	bmclibErrs "github.com/metal-toolbox/bmclib/errors"
 	"github.com/pkg/errors"
 	"github.com/stmcginnis/gofish/redfish"
 )
 
-// ClearSystemEventLog clears all of the LogServices logs
+// ClearSystemEventLog clears the LogServices of the Chassis, the Manager LogServices read by GetSystemEventLog
+// are left untouched, ClearLogService clears a specific LogService.
 func (c *Client) ClearSystemEventLog(ctx context.Context) (err error) {
 	if err := c.SessionActive(); err != nil {
 		return errors.Wrap(bmclibErrs.ErrNotAuthenticated, err.Error())
@@ -17,7 +21,7 @@ func (c *Client) ClearSystemEventLog(ctx context.Context) (err error) {
 
 	chassis, err := c.client.Service.Chassis()
 	if err != nil {
-		return err
+		return providerError(err)
 	}
 
 	for _, c := range chassis {
@@ -29,7 +33,7 @@ func (c *Client) ClearSystemEventLog(ctx context.Context) (err error) {
 		for _, logService := range logServices {
 			err = logService.ClearLog()
 			if err != nil {
-				return err
+				return providerError(err)
 			}
 		}
 	}
@@ -37,41 +41,133 @@ func (c *Client) ClearSystemEventLog(ctx context.Context) (err error) {
 	return nil
 }
 
-// GetSystemEventLog returns the SystemEventLogEntries
+// GetSystemEventLog returns the entries of the Manager LogServices in the ID, Created, Description, Message column format,
+// the System and Chassis LogServices are not read, GetLogServiceEntries reads a specific LogService.
 func (c *Client) GetSystemEventLog(ctx context.Context) (entries [][]string, err error) {
+	logServices, err := c.managerLogServices(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, logService := range logServices {
+		lentries, err := logService.Entries()
+		if err != nil {
+			return nil, providerError(err)
+		}
+
+		for _, entry := range lentries {
+			entries = append(entries, systemEventLogColumns(entry))
+		}
+	}
+
+	return entries, nil
+}
+
+// GetSystemEventLogEntries returns the entries of the Manager LogServices, the entry LogService is set
+// to the LogService @odata.id since the entry IDs are only unique within their LogService.
+func (c *Client) GetSystemEventLogEntries(ctx context.Context) (entries []bmc.SystemEventLogEntry, err error) {
+	logServices, err := c.managerLogServices(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entries = []bmc.SystemEventLogEntry{}
+	for _, logService := range logServices {
+		lentries, err := logService.Entries()
+		if err != nil {
+			return nil, providerError(err)
+		}
+
+		for _, entry := range lentries {
+			entries = append(entries, systemEventLogEntry(logService.ODataID, entry))
+		}
+	}
+
+	return entries, nil
+}
+
+// managerLogServices returns the LogServices of the Managers
+func (c *Client) managerLogServices(ctx context.Context) (logServices []*redfish.LogService, err error) {
 	if err := c.SessionActive(); err != nil {
 		return nil, errors.Wrap(bmclibErrs.ErrNotAuthenticated, err.Error())
 	}
 
 	managers, err := c.client.Service.Managers()
 	if err != nil {
-		return nil, err
+		return nil, providerError(err)
 	}
 
 	for _, m := range managers {
-		logServices, err := m.LogServices()
+		mlogServices, err := m.LogServices()
 		if err != nil {
 			return nil, err
 		}
 
-		for _, logService := range logServices {
-			lentries, err := logService.Entries()
-			if err != nil {
-				return nil, err
-			}
+		logServices = append(logServices, mlogServices...)
+	}
 
-			for _, entry := range lentries {
-				entries = append(entries, []string{
-					entry.ID,
-					entry.Created,
-					entry.Description,
-					entry.Message,
-				})
-			}
-		}
+	return logServices, nil
+}
+
+// systemEventLogColumns returns the LogEntry in the ID, Created, Description, Message column format
+func systemEventLogColumns(entry *redfish.LogEntry) []string {
+	return []string{
+		entry.ID,
+		entry.Created,
+		entry.Description,
+		entry.Message,
 	}
+}
 
-	return entries, nil
+// systemEventLogEntry returns the System Event Log entry for the Redfish LogEntry of the given LogService
+func systemEventLogEntry(logService string, entry *redfish.LogEntry) bmc.SystemEventLogEntry {
+	e := bmc.SystemEventLogEntry{
+		ID:         entry.ID,
+		Severity:   bmc.SystemEventLogSeverityUnknown,
+		SensorType: string(entry.SensorType),
+		Message:    entry.Message,
+		LogService: logService,
+	}
+
+	if e.SensorType == "" {
+		e.SensorType = entry.OemSensorType
+	}
+
+	// SensorNumber is only meaningful for entries with a SensorType
+	if e.SensorType != "" {
+		e.SensorNumber = fmt.Sprintf("0x%02x", entry.SensorNumber)
+	}
+
+	created := entry.Created
+	if created == "" {
+		created = entry.EventTimestamp
+	}
+
+	if ts, err := time.Parse(time.RFC3339, created); err == nil {
+		e.Timestamp = ts
+	}
+
+	switch entry.Severity {
+	case redfish.OKEventSeverity:
+		e.Severity = bmc.SystemEventLogSeverityInfo
+	case redfish.WarningEventSeverity:
+		e.Severity = bmc.SystemEventLogSeverityWarning
+	case redfish.CriticalEventSeverity:
+		e.Severity = bmc.SystemEventLogSeverityCritical
+	}
+
+	switch entry.EntryCode {
+	case redfish.AssertLogEntryCode:
+		e.Direction = bmc.SystemEventAsserted
+	case redfish.DeassertLogEntryCode:
+		e.Direction = bmc.SystemEventDeasserted
+	}
+
+	if raw, err := json.Marshal(entry); err == nil {
+		e.Raw = string(raw)
+	}
+
+	return e
 }
 
 // GetSystemEventLogRaw returns the raw SEL
@@ -84,7 +180,7 @@ func (c *Client) GetSystemEventLogRaw(ctx context.Context) (eventlog string, err
 
 	managers, err := c.client.Service.Managers()
 	if err != nil {
-		return "", err
+		return "", providerError(err)
 	}
 
 	for _, m := range managers {
@@ -96,7 +192,7 @@ func (c *Client) GetSystemEventLogRaw(ctx context.Context) (eventlog string, err
 		for _, logService := range logServices {
 			lentries, err := logService.Entries()
 			if err != nil {
-				return "", err
+				return "", providerError(err)
 			}
 
 			allEntries = append(allEntries, lentries...)
@@ -0,0 +1,67 @@
+package redfishwrapper
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/metal-toolbox/bmclib/bmc"
+	"github.com/stmcginnis/gofish/redfish"
+	"github.com/stretchr/testify/assert"
+)
+
+// selLogEntry is a Dell iDRAC SEL LogEntry
+const selLogEntry = `{
+	"@odata.id": "/redfish/v1/Managers/iDRAC.Embedded.1/LogServices/Sel/Entries/1",
+	"Id": "1",
+	"Created": "2024-03-19T10:11:12-05:00",
+	"Description": "Log Entry 1",
+	"EntryCode": "Assert",
+	"EntryType": "SEL",
+	"Message": "The system inlet temperature is greater than the upper critical threshold.",
+	"SensorNumber": 48,
+	"SensorType": "Temperature",
+	"Severity": "Critical"
+}`
+
+func TestSystemEventLogEntry(t *testing.T) {
+	entry := &redfish.LogEntry{}
+	if err := json.Unmarshal([]byte(selLogEntry), entry); err != nil {
+		t.Fatal(err)
+	}
+
+	got := systemEventLogEntry("/redfish/v1/Managers/iDRAC.Embedded.1/LogServices/Sel", entry)
+	assert.NotEmpty(t, got.Raw)
+
+	got.Raw = ""
+	want := bmc.SystemEventLogEntry{
+		ID:           "1",
+		Timestamp:    time.Date(2024, 3, 19, 15, 11, 12, 0, time.UTC),
+		Severity:     bmc.SystemEventLogSeverityCritical,
+		SensorType:   "Temperature",
+		SensorNumber: "0x30",
+		Direction:    bmc.SystemEventAsserted,
+		Message:      "The system inlet temperature is greater than the upper critical threshold.",
+		LogService:   "/redfish/v1/Managers/iDRAC.Embedded.1/LogServices/Sel",
+	}
+
+	assert.True(t, want.Timestamp.Equal(got.Timestamp))
+	got.Timestamp = want.Timestamp
+	assert.Equal(t, want, got)
+}
+
+func TestSystemEventLogColumns(t *testing.T) {
+	entry := &redfish.LogEntry{}
+	if err := json.Unmarshal([]byte(selLogEntry), entry); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"1",
+		"2024-03-19T10:11:12-05:00",
+		"Log Entry 1",
+		"The system inlet temperature is greater than the upper critical threshold.",
+	}
+
+	assert.Equal(t, want, systemEventLogColumns(entry))
+}
@@ -0,0 +1,231 @@
+package redfishwrapper
+
+import (
+	"context"
+
+	"github.com/metal-toolbox/bmclib/bmc"
+	bmclibErrs "github.com/metal-toolbox/bmclib/errors"
+	"github.com/pkg/errors"
+	"github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// SensorsRead returns the temperature, fan and voltage readings of all chassis.
+//
+// The temperatures and fans are read from the Chassis Thermal resource, services that have
+// replaced it with the ThermalSubsystem report them in its ThermalMetrics and Fans,
+// those readings come without thresholds. The voltages are read from the Chassis Power resource.
+func (c *Client) SensorsRead(ctx context.Context) ([]bmc.SensorReading, error) {
+	if err := c.SessionActive(); err != nil {
+		return nil, errors.Wrap(bmclibErrs.ErrNotAuthenticated, err.Error())
+	}
+
+	chassis, err := c.client.Service.Chassis()
+	if err != nil {
+		return nil, providerError(err)
+	}
+
+	readings := []bmc.SensorReading{}
+	for _, ch := range chassis {
+		thermal, err := ch.Thermal()
+		if err != nil {
+			return nil, providerError(err)
+		}
+
+		if thermal != nil {
+			readings = append(readings, thermalSensorReadings(thermal)...)
+		} else {
+			subsystemReadings, err := thermalSubsystemSensorReadings(ch)
+			if err != nil {
+				return nil, err
+			}
+
+			readings = append(readings, subsystemReadings...)
+		}
+
+		power, err := ch.Power()
+		if err != nil {
+			return nil, providerError(err)
+		}
+
+		if power != nil {
+			readings = append(readings, voltageSensorReadings(power.Voltages)...)
+		}
+	}
+
+	return readings, nil
+}
+
+// thermalSubsystemSensorReadings returns the temperature and fan readings of the chassis ThermalSubsystem.
+func thermalSubsystemSensorReadings(ch *redfish.Chassis) ([]bmc.SensorReading, error) {
+	subsystem, err := ch.ThermalSubsystem()
+	if err != nil {
+		return nil, providerError(err)
+	}
+
+	if subsystem == nil {
+		return nil, nil
+	}
+
+	var readings []bmc.SensorReading
+
+	metrics, err := subsystem.ThermalMetrics()
+	if err != nil {
+		return nil, providerError(err)
+	}
+
+	if metrics != nil {
+		for _, t := range metrics.TemperatureReadingsCelsius {
+			readings = append(readings, bmc.SensorReading{
+				Name:   t.DeviceName,
+				Type:   bmc.SensorTypeTemperature,
+				Value:  float64Ptr(t.Reading),
+				Unit:   bmc.SensorUnitCelsius,
+				Status: bmc.SensorStatusUnknown,
+			})
+		}
+	}
+
+	fans, err := subsystem.Fans()
+	if err != nil {
+		return nil, providerError(err)
+	}
+
+	for _, f := range fans {
+		reading := bmc.SensorReading{
+			Name:   f.Name,
+			Type:   bmc.SensorTypeFan,
+			Value:  float64Ptr(f.SpeedPercent.Reading),
+			Unit:   bmc.SensorUnitPercent,
+			Status: sensorStatus(f.Status),
+		}
+
+		if f.SpeedPercent.SpeedRPM != 0 {
+			reading.Value = float64Ptr(f.SpeedPercent.SpeedRPM)
+			reading.Unit = bmc.SensorUnitRPM
+		}
+
+		readings = append(readings, reading)
+	}
+
+	return readings, nil
+}
+
+// thermalSensorReadings returns the temperature and fan readings of the Chassis Thermal resource.
+func thermalSensorReadings(thermal *redfish.Thermal) []bmc.SensorReading {
+	readings := make([]bmc.SensorReading, 0, len(thermal.Temperatures)+len(thermal.Fans))
+
+	for i := range thermal.Temperatures {
+		t := &thermal.Temperatures[i]
+		readings = append(readings, bmc.SensorReading{
+			Name:   t.Name,
+			Type:   bmc.SensorTypeTemperature,
+			Value:  sensorValue(float64(t.ReadingCelsius), t.Status),
+			Unit:   bmc.SensorUnitCelsius,
+			Status: sensorStatus(t.Status),
+			Thresholds: bmc.SensorThresholds{
+				LowerNonCritical:    sensorThreshold(float64(t.LowerThresholdNonCritical)),
+				LowerCritical:       sensorThreshold(float64(t.LowerThresholdCritical)),
+				LowerNonRecoverable: sensorThreshold(float64(t.LowerThresholdFatal)),
+				UpperNonCritical:    sensorThreshold(float64(t.UpperThresholdNonCritical)),
+				UpperCritical:       sensorThreshold(float64(t.UpperThresholdCritical)),
+				UpperNonRecoverable: sensorThreshold(float64(t.UpperThresholdFatal)),
+			},
+		})
+	}
+
+	for i := range thermal.Fans {
+		f := &thermal.Fans[i]
+
+		unit := bmc.SensorUnitRPM
+		if f.ReadingUnits == redfish.PercentReadingUnits {
+			unit = bmc.SensorUnitPercent
+		}
+
+		readings = append(readings, bmc.SensorReading{
+			Name:   f.Name,
+			Type:   bmc.SensorTypeFan,
+			Value:  sensorValue(float64(f.Reading), f.Status),
+			Unit:   unit,
+			Status: sensorStatus(f.Status),
+			Thresholds: bmc.SensorThresholds{
+				LowerNonCritical:    sensorThreshold(float64(f.LowerThresholdNonCritical)),
+				LowerCritical:       sensorThreshold(float64(f.LowerThresholdCritical)),
+				LowerNonRecoverable: sensorThreshold(float64(f.LowerThresholdFatal)),
+				UpperNonCritical:    sensorThreshold(float64(f.UpperThresholdNonCritical)),
+				UpperCritical:       sensorThreshold(float64(f.UpperThresholdCritical)),
+				UpperNonRecoverable: sensorThreshold(float64(f.UpperThresholdFatal)),
+			},
+		})
+	}
+
+	return readings
+}
+
+// voltageSensorReadings returns the readings of the Chassis Power voltages.
+func voltageSensorReadings(voltages []redfish.Voltage) []bmc.SensorReading {
+	readings := make([]bmc.SensorReading, 0, len(voltages))
+
+	for i := range voltages {
+		v := &voltages[i]
+		readings = append(readings, bmc.SensorReading{
+			Name:   v.Name,
+			Type:   bmc.SensorTypeVoltage,
+			Value:  sensorValue(float64(v.ReadingVolts), v.Status),
+			Unit:   bmc.SensorUnitVolts,
+			Status: sensorStatus(v.Status),
+			Thresholds: bmc.SensorThresholds{
+				LowerNonCritical:    sensorThreshold(float64(v.LowerThresholdNonCritical)),
+				LowerCritical:       sensorThreshold(float64(v.LowerThresholdCritical)),
+				LowerNonRecoverable: sensorThreshold(float64(v.LowerThresholdFatal)),
+				UpperNonCritical:    sensorThreshold(float64(v.UpperThresholdNonCritical)),
+				UpperCritical:       sensorThreshold(float64(v.UpperThresholdCritical)),
+				UpperNonRecoverable: sensorThreshold(float64(v.UpperThresholdFatal)),
+			},
+		})
+	}
+
+	return readings
+}
+
+// sensorStatus returns the sensor status for the Redfish resource status.
+func sensorStatus(status common.Status) bmc.SensorStatus {
+	switch status.State {
+	case common.AbsentState, common.DisabledState, common.UnavailableOfflineState:
+		return bmc.SensorStatusUnavailable
+	}
+
+	switch status.Health {
+	case common.OKHealth:
+		return bmc.SensorStatusOK
+	case common.WarningHealth:
+		return bmc.SensorStatusWarning
+	case common.CriticalHealth:
+		return bmc.SensorStatusCritical
+	default:
+		return bmc.SensorStatusUnknown
+	}
+}
+
+// sensorValue returns the reading of a sensor, absent and disabled sensors have no reading.
+func sensorValue(value float64, status common.Status) *float64 {
+	if sensorStatus(status) == bmc.SensorStatusUnavailable {
+		return nil
+	}
+
+	return float64Ptr(value)
+}
+
+// sensorThreshold returns the threshold value, Redfish services omit the thresholds they don't support
+// which leaves them at zero.
+func sensorThreshold(value float64) *float64 {
+	if value == 0 {
+		return nil
+	}
+
+	return float64Ptr(value)
+}
+
+func float64Ptr(value float64) *float64 {
+	return &value
+}
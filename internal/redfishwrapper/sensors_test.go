@@ -0,0 +1,96 @@
+package redfishwrapper
+
+import (
+	"testing"
+
+	"github.com/metal-toolbox/bmclib/bmc"
+	"github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThermalSensorReadings(t *testing.T) {
+	thermal := &redfish.Thermal{
+		Temperatures: []redfish.Temperature{
+			{
+				Entity:                    common.Entity{Name: "CPU1 Temp"},
+				ReadingCelsius:            42.5,
+				UpperThresholdNonCritical: 85,
+				UpperThresholdCritical:    95,
+				Status:                    common.Status{State: common.EnabledState, Health: common.OKHealth},
+			},
+			{
+				Entity:         common.Entity{Name: "CPU2 Temp"},
+				ReadingCelsius: 0,
+				Status:         common.Status{State: common.AbsentState},
+			},
+		},
+		Fans: []redfish.ThermalFan{
+			{
+				Entity:                 common.Entity{Name: "Fan1"},
+				Reading:                6240,
+				ReadingUnits:           redfish.RPMReadingUnits,
+				LowerThresholdCritical: 720,
+				Status:                 common.Status{State: common.EnabledState, Health: common.WarningHealth},
+			},
+		},
+	}
+
+	want := []bmc.SensorReading{
+		{
+			Name:   "CPU1 Temp",
+			Type:   bmc.SensorTypeTemperature,
+			Value:  float64Ptr(42.5),
+			Unit:   bmc.SensorUnitCelsius,
+			Status: bmc.SensorStatusOK,
+			Thresholds: bmc.SensorThresholds{
+				UpperNonCritical: float64Ptr(85),
+				UpperCritical:    float64Ptr(95),
+			},
+		},
+		{
+			Name:   "CPU2 Temp",
+			Type:   bmc.SensorTypeTemperature,
+			Unit:   bmc.SensorUnitCelsius,
+			Status: bmc.SensorStatusUnavailable,
+		},
+		{
+			Name:       "Fan1",
+			Type:       bmc.SensorTypeFan,
+			Value:      float64Ptr(6240),
+			Unit:       bmc.SensorUnitRPM,
+			Status:     bmc.SensorStatusWarning,
+			Thresholds: bmc.SensorThresholds{LowerCritical: float64Ptr(720)},
+		},
+	}
+
+	assert.Equal(t, want, thermalSensorReadings(thermal))
+}
+
+func TestVoltageSensorReadings(t *testing.T) {
+	voltages := []redfish.Voltage{
+		{
+			Entity:                 common.Entity{Name: "PS1 Voltage 1"},
+			ReadingVolts:           230,
+			LowerThresholdCritical: 180,
+			UpperThresholdCritical: 264,
+			Status:                 common.Status{State: common.EnabledState, Health: common.CriticalHealth},
+		},
+	}
+
+	want := []bmc.SensorReading{
+		{
+			Name:   "PS1 Voltage 1",
+			Type:   bmc.SensorTypeVoltage,
+			Value:  float64Ptr(230),
+			Unit:   bmc.SensorUnitVolts,
+			Status: bmc.SensorStatusCritical,
+			Thresholds: bmc.SensorThresholds{
+				LowerCritical: float64Ptr(180),
+				UpperCritical: float64Ptr(264),
+			},
+		},
+	}
+
+	assert.Equal(t, want, voltageSensorReadings(voltages))
+}
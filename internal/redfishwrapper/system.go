@@ -37,7 +37,7 @@ func (c *Client) Systems() ([]*redfish.ComputerSystem, error) {
 
 	s, err := c.client.Service.Systems()
 	if err != nil {
-		return nil, err
+		return nil, providerError(err)
 	}
 
 	return c.matchingSystem(s), nil
@@ -51,7 +51,7 @@ func (c *Client) Managers(ctx context.Context) ([]*redfish.Manager, error) {
 
 	ms, err := c.client.Service.Managers()
 	if err != nil {
-		return nil, err
+		return nil, providerError(err)
 	}
 
 	for _, m := range ms {
@@ -75,7 +75,12 @@ func (c *Client) Chassis(ctx context.Context) ([]*redfish.Chassis, error) {
 		return nil, errors.Wrap(bmclibErrs.ErrNotAuthenticated, err.Error())
 	}
 
-	return c.client.Service.Chassis()
+	chassis, err := c.client.Service.Chassis()
+	if err != nil {
+		return nil, providerError(err)
+	}
+
+	return chassis, nil
 }
 
 func (c *Client) matchingSystem(systems []*redfish.ComputerSystem) []*redfish.ComputerSystem {
@@ -0,0 +1,60 @@
+package bmclib
+
+import (
+	"context"
+	"sync"
+
+	"github.com/metal-toolbox/bmclib/bmc"
+)
+
+type callMetadataCtxKey struct{}
+
+// CallMetadata collects the metadata of the Client method calls made with a context
+// returned by WithCallMetadata.
+//
+// Unlike Client.GetMetadata, which returns the metadata of the last call made on the Client
+// by any goroutine, CallMetadata only holds the metadata of the calls made with its context.
+type CallMetadata struct {
+	mu    sync.Mutex
+	calls []bmc.Metadata
+}
+
+// WithCallMetadata returns a context carrying a CallMetadata that is populated
+// when the context is passed to Client methods.
+//
+//	ctx, md := bmclib.WithCallMetadata(ctx)
+//	state, err := client.GetPowerState(ctx)
+//	log.Info("power state", "provider", md.Metadata().SuccessfulProvider)
+func WithCallMetadata(ctx context.Context) (context.Context, *CallMetadata) {
+	cm := &CallMetadata{}
+
+	return context.WithValue(ctx, callMetadataCtxKey{}, cm), cm
+}
+
+// Metadata returns the metadata of the last call made with the context,
+// an empty Metadata is returned when no call was made.
+func (c *CallMetadata) Metadata() bmc.Metadata {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.calls) == 0 {
+		return bmc.Metadata{}
+	}
+
+	return c.calls[len(c.calls)-1]
+}
+
+// All returns the metadata of each call made with the context, in the order the calls returned.
+func (c *CallMetadata) All() []bmc.Metadata {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]bmc.Metadata(nil), c.calls...)
+}
+
+func (c *CallMetadata) add(metadata bmc.Metadata) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.calls = append(c.calls, metadata)
+}
@@ -8,7 +8,10 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/jacobweinstock/registrar"
+	"github.com/metal-toolbox/bmclib/bmc"
+	"github.com/metal-toolbox/bmclib/credentials"
 	"github.com/metal-toolbox/bmclib/internal/httpclient"
+	"github.com/metal-toolbox/bmclib/providers"
 	"github.com/metal-toolbox/bmclib/providers/rpc"
 	oteltrace "go.opentelemetry.io/otel/trace"
 )
@@ -54,6 +57,54 @@ func WithPerProviderTimeout(timeout time.Duration) Option {
 	}
 }
 
+// WithExecutionStrategy sets how read only methods (PowerState, GetBootDeviceOverride, Inventory,
+// GetSystemEventLog, PostCode) are dispatched across providers.
+// The default, bmc.ExecutionSequential, tries providers one after the other.
+// With bmc.ExecutionConsensus the per provider results are compared and any disagreement is
+// reported in the Metadata Conflict field.
+// A strategy set on the context of a method call with bmc.WithExecutionStrategy takes precedence.
+func WithExecutionStrategy(strategy bmc.ExecutionStrategy) Option {
+	return func(args *Client) {
+		args.executionStrategy = strategy
+	}
+}
+
+// WithRetryPolicy sets the policy for retrying provider method calls that fail with a transient
+// or BMC busy error, the retries are made against the same provider before moving on to the next one.
+// Non idempotent methods like power cycle or BMC reset are not retried unless
+// bmc.RetryPolicy.RetryNonIdempotent is set.
+// RetryNonIdempotent does not cover the methods that stream a payload (FirmwareInstall, FirmwareUpload,
+// FirmwareInstallUploadAndInitiate, MountFloppyImage), these are retried only with an entry
+// for the method in bmc.RetryPolicy.Overrides that sets RetryNonIdempotent, and a payload that implements io.Seeker.
+// A policy set on the context of a method call with bmc.WithRetryPolicy takes precedence.
+func WithRetryPolicy(policy bmc.RetryPolicy) Option {
+	return func(args *Client) {
+		args.retryPolicy = &policy
+	}
+}
+
+// WithProviders registers providers that are not built into bmclib, each provider is constructed
+// by its factory with the Client host, credentials, http client and logger.
+// The providers are registered after the built-in providers, or added to the registry set with WithRegistry.
+func WithProviders(factories ...providers.Factory) Option {
+	return func(args *Client) {
+		args.providerFactories = append(args.providerFactories, factories...)
+	}
+}
+
+// WithoutBuiltinProviders disables the built-in providers with the given names, for example ipmitool.ProviderName,
+// all built-in providers are disabled when no names are given.
+func WithoutBuiltinProviders(names ...string) Option {
+	return func(args *Client) {
+		if len(names) == 0 {
+			args.disableBuiltinProviders = true
+			return
+		}
+
+		args.disabledBuiltinProviders = append(args.disabledBuiltinProviders, names...)
+	}
+}
+
 func WithIpmitoolCipherSuite(cipherSuite string) Option {
 	return func(args *Client) {
 		args.providerConfig.ipmitool.CipherSuite = cipherSuite
@@ -167,3 +218,25 @@ func WithTracerProvider(provider oteltrace.TracerProvider) Option {
 		}
 	}
 }
+
+// WithCredentialProvider sets the source of the BMC credentials, the credentials passed to NewClient
+// are replaced with the ones it returns when Open is called, and again when a provider fails to authenticate,
+// during Open or a method call. When the credentials were updated after a method call failed to authenticate,
+// the providers are opened with them and the method call is made once more.
+// This allows long lived Clients to pick up rotated BMC passwords.
+func WithCredentialProvider(provider CredentialProvider) Option {
+	return func(args *Client) {
+		args.credentialProvider = provider
+	}
+}
+
+// WithFallbackCredentials sets credentials that Open tries, in order, for the providers that fail to authenticate
+// with the Client credentials, for example the factory default credentials of newly racked machines.
+// When no provider could be opened with the Client credentials, the Client credentials are updated to the
+// fallback credentials that opened the first provider in the registry.
+// Fallback credentials are not used with a registry set with WithRegistry, since its providers are constructed by the caller.
+func WithFallbackCredentials(creds ...credentials.Credentials) Option {
+	return func(args *Client) {
+		args.fallbackCredentials = append(args.fallbackCredentials, creds...)
+	}
+}
@@ -0,0 +1,280 @@
+package bmclib
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/metal-toolbox/bmclib/bmc"
+	bmclibErrs "github.com/metal-toolbox/bmclib/errors"
+)
+
+const (
+	// default interval between power state polls
+	defaultPowerPollInterval = 5 * time.Second
+	// default time to wait for the expected power state
+	defaultPowerWaitTimeout = 5 * time.Minute
+)
+
+// PowerWaitOptions configure how SetPowerStateAndWait waits for the power state.
+type PowerWaitOptions struct {
+	// Target is the power state to wait for, it defaults to Off for the off and soft actions,
+	// and On for the on, reset and cycle actions.
+	Target bmc.PowerState
+	// PollInterval is the time between power state polls, defaults to 5 seconds.
+	PollInterval time.Duration
+	// Timeout is the time to wait for the Target state once the action was accepted, defaults to 5 minutes.
+	Timeout time.Duration
+	// Provider is the name of the provider the power state is polled through,
+	// for example to confirm an action performed over Redfish with ipmitool. All providers are used when empty.
+	Provider string
+}
+
+// withDefaults returns the options with the defaults set for the action.
+func (o PowerWaitOptions) withDefaults(action bmc.PowerAction) PowerWaitOptions {
+	if o.Target == "" {
+		o.Target = bmc.PowerStateOn
+		if action == bmc.PowerActionOff || action == bmc.PowerActionSoft {
+			o.Target = bmc.PowerStateOff
+		}
+	}
+
+	if o.PollInterval <= 0 {
+		o.PollInterval = defaultPowerPollInterval
+	}
+
+	if o.Timeout <= 0 {
+		o.Timeout = defaultPowerWaitTimeout
+	}
+
+	return o
+}
+
+// PowerObservation is a power state observed while waiting for a power state.
+type PowerObservation struct {
+	Time  time.Time
+	State bmc.PowerState
+	// Err is set when the power state could not be read, the State is then Unknown.
+	Err error
+}
+
+// PowerTransition is the timeline of a power action performed with SetPowerStateAndWait.
+type PowerTransition struct {
+	Action bmc.PowerAction
+	Target bmc.PowerState
+	// Provider is the name of the provider that performed the action.
+	Provider string
+	// Accepted is the time the action was accepted by the BMC.
+	Accepted time.Time
+	// Observations holds the changes in the observed power state, in order,
+	// repeated observations of the same state are not included.
+	Observations []PowerObservation
+	// Polls is the number of times the power state was read.
+	Polls int
+	// Reached is set when the Target state was observed.
+	Reached bool
+}
+
+// Duration returns the time from the action being accepted to the Target state being observed,
+// zero when it was not observed.
+func (t PowerTransition) Duration() time.Duration {
+	if !t.Reached || len(t.Observations) == 0 {
+		return 0
+	}
+
+	return t.Observations[len(t.Observations)-1].Time.Sub(t.Accepted)
+}
+
+// SetPowerStateAndWait performs the power action and then polls the power state until the Target state
+// in the options is observed, or the options Timeout or the context deadline is reached.
+//
+// The transition timeline is returned along with an error wrapping errors.ErrPowerStateTimeout
+// when the Target state was not observed in time, or wrapping the context error when the context
+// is canceled or its deadline is reached first.
+// For the reset and cycle actions, the On state may be observed before the machine was powered off.
+func (c *Client) SetPowerStateAndWait(ctx context.Context, action bmc.PowerAction, opts PowerWaitOptions) (PowerTransition, error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "SetPowerStateAndWait")
+	defer span.End()
+
+	ctx = c.withCallOptions(ctx)
+
+	transition, metadata, err := c.setPowerStateAndWait(ctx, action, "", opts)
+	c.setMetadata(ctx, metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return transition, err
+}
+
+// setPowerStateAndWait performs the power action, through the given provider when not empty, and waits for the power state.
+func (c *Client) setPowerStateAndWait(ctx context.Context, action bmc.PowerAction, provider string, opts PowerWaitOptions) (PowerTransition, bmc.Metadata, error) {
+	opts = opts.withDefaults(action)
+	transition := PowerTransition{Action: action, Target: opts.Target}
+
+	setter := c
+	if provider != "" {
+		setter = c.For(provider)
+	}
+
+	_, metadata, err := bmc.SetPowerStateFromInterfaces(ctx, setter.perProviderTimeout(ctx), string(action), setter.registry().GetDriverInterfaces())
+	if setter.reauthenticateMutation(ctx, metadata, err) {
+		_, metadata, err = bmc.SetPowerStateFromInterfaces(ctx, setter.perProviderTimeout(ctx), string(action), setter.registry().GetDriverInterfaces())
+	}
+	if err != nil {
+		return transition, metadata, err
+	}
+
+	transition.Provider = metadata.SuccessfulProvider
+	transition.Accepted = time.Now()
+
+	poller := c
+	if opts.Provider != "" {
+		poller = c.For(opts.Provider)
+	}
+
+	return transition, metadata, poller.waitForPowerState(ctx, opts, &transition)
+}
+
+// waitForPowerState polls the power state until the options Target state is observed,
+// the observations are recorded on the transition.
+//
+// errors.ErrPowerStateTimeout is returned when the options Timeout expired,
+// the error of the given context is wrapped when it is done before.
+func (c *Client) waitForPowerState(parent context.Context, opts PowerWaitOptions, transition *PowerTransition) error {
+	ctx, cancel := context.WithTimeout(parent, opts.Timeout)
+	defer cancel()
+
+	timeoutErr := func() error {
+		if err := parent.Err(); err != nil {
+			return fmt.Errorf("waiting for power state %s: %w", opts.Target, err)
+		}
+
+		last := bmc.PowerStateUnknown
+		if n := len(transition.Observations); n > 0 {
+			last = transition.Observations[n-1].State
+		}
+
+		return fmt.Errorf("%w: %s not observed after %d polls, last observed: %s", bmclibErrs.ErrPowerStateTimeout, opts.Target, transition.Polls, last)
+	}
+
+	for {
+		state, _, err := bmc.PowerStateFromInterfaces(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
+		if err != nil && ctx.Err() != nil {
+			// the poll was interrupted by the timeout or the caller, it is not an observation.
+			return timeoutErr()
+		}
+
+		observation := PowerObservation{Time: time.Now(), State: state, Err: err}
+
+		transition.Polls++
+		if n := len(transition.Observations); n == 0 || !sameObservation(transition.Observations[n-1], observation) {
+			transition.Observations = append(transition.Observations, observation)
+		}
+
+		if err == nil && observation.State == opts.Target {
+			transition.Reached = true
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return timeoutErr()
+		case <-time.After(opts.PollInterval):
+		}
+	}
+}
+
+// sameObservation returns true when the observations are of the same state, or failed with the same error.
+func sameObservation(a, b PowerObservation) bool {
+	if (a.Err == nil) != (b.Err == nil) {
+		return false
+	}
+
+	if a.Err != nil {
+		return a.Err.Error() == b.Err.Error()
+	}
+
+	return a.State == b.State
+}
+
+// GracefulPowerOffOptions configure GracefulPowerOff.
+type GracefulPowerOffOptions struct {
+	// GracePeriod is the time the OS is given to shut down before the machine is hard powered off, defaults to 5 minutes.
+	GracePeriod time.Duration
+	// PollInterval is the time between power state polls, defaults to 5 seconds.
+	PollInterval time.Duration
+	// PollProvider is the name of the provider the power state is polled through, all providers are used when empty.
+	PollProvider string
+	// EscalationProvider is the name of the provider the hard power off is performed through,
+	// all providers are tried when empty.
+	EscalationProvider string
+	// EscalationTimeout is the time to wait for the machine to be off after the hard power off, defaults to 1 minute.
+	EscalationTimeout time.Duration
+}
+
+// default time to wait for the machine to be off after a hard power off
+const defaultEscalationTimeout = time.Minute
+
+// GracefulPowerOffResult holds the power transitions of GracefulPowerOff.
+type GracefulPowerOffResult struct {
+	// Soft is the transition of the ACPI soft power off.
+	Soft PowerTransition
+	// Hard is the transition of the hard power off, it is set when the soft power off was escalated.
+	Hard *PowerTransition
+}
+
+// Escalated returns true when the machine was hard powered off after the grace period.
+func (r GracefulPowerOffResult) Escalated() bool {
+	return r.Hard != nil
+}
+
+// GracefulPowerOff asks the OS to shut down with an ACPI soft power off through the first provider that accepts it,
+// and waits for the machine to be off. When the machine is still on after the grace period it is hard powered off,
+// the Metadata Escalated field is set when this happens.
+//
+// An error is returned when the soft power off is not accepted by any provider, without escalating,
+// or when the machine is still not off after the hard power off.
+func (c *Client) GracefulPowerOff(ctx context.Context, opts GracefulPowerOffOptions) (GracefulPowerOffResult, error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "GracefulPowerOff")
+	defer span.End()
+
+	ctx = c.withCallOptions(ctx)
+
+	if opts.GracePeriod <= 0 {
+		opts.GracePeriod = defaultPowerWaitTimeout
+	}
+
+	if opts.EscalationTimeout <= 0 {
+		opts.EscalationTimeout = defaultEscalationTimeout
+	}
+
+	waitOpts := PowerWaitOptions{
+		Target:       bmc.PowerStateOff,
+		PollInterval: opts.PollInterval,
+		Timeout:      opts.GracePeriod,
+		Provider:     opts.PollProvider,
+	}
+
+	var result GracefulPowerOffResult
+	soft, metadata, err := c.setPowerStateAndWait(ctx, bmc.PowerActionSoft, "", waitOpts)
+	result.Soft = soft
+	if err == nil || !errors.Is(err, bmclibErrs.ErrPowerStateTimeout) || ctx.Err() != nil {
+		c.setMetadata(ctx, metadata)
+		metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+		return result, err
+	}
+
+	c.Logger.V(1).Info("machine still on after the grace period, escalating to a hard power off", "host", c.Auth.Host, "gracePeriod", opts.GracePeriod.String())
+
+	waitOpts.Timeout = opts.EscalationTimeout
+	hard, hardMetadata, err := c.setPowerStateAndWait(ctx, bmc.PowerActionOff, opts.EscalationProvider, waitOpts)
+	result.Hard = &hard
+
+	hardMetadata.ProvidersAttempted = append(metadata.ProvidersAttempted, hardMetadata.ProvidersAttempted...)
+	hardMetadata.Escalated = true
+	c.setMetadata(ctx, hardMetadata)
+	hardMetadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return result, err
+}
@@ -41,6 +41,7 @@ var (
 		providers.FeatureInventoryRead,
 		providers.FeaturePowerSet,
 		providers.FeaturePowerState,
+		providers.FeatureSensorsRead,
 	}
 )
 
@@ -144,7 +144,7 @@ func (a *ASRockRack) listUsers(ctx context.Context) ([]*UserAccount, error) {
 	}
 
 	if statusCode != http.StatusOK {
-		return nil, fmt.Errorf("non 200 response: %d", statusCode)
+		return nil, nonOKResponseErr(statusCode)
 	}
 
 	accounts := []*UserAccount{}
@@ -172,7 +172,7 @@ func (a *ASRockRack) createUpdateUser(ctx context.Context, account *UserAccount)
 	}
 
 	if statusCode != http.StatusOK {
-		return fmt.Errorf("non 200 response: %d", statusCode)
+		return nonOKResponseErr(statusCode)
 	}
 
 	return nil
@@ -207,7 +207,7 @@ func (a *ASRockRack) setFlashMode(ctx context.Context) error {
 	}
 
 	if statusCode != http.StatusOK {
-		return fmt.Errorf("non 200 response: %d", statusCode)
+		return nonOKResponseErr(statusCode)
 	}
 
 	a.resetRequired = true
@@ -280,7 +280,7 @@ func (a *ASRockRack) uploadFirmware(ctx context.Context, endpoint string, file *
 	}
 
 	if statusCode != http.StatusOK {
-		return fmt.Errorf("non 200 response: %d", statusCode)
+		return nonOKResponseErr(statusCode)
 	}
 
 	return nil
@@ -294,7 +294,7 @@ func (a *ASRockRack) verifyUploadedFirmware(ctx context.Context) error {
 	}
 
 	if statusCode != http.StatusOK {
-		return fmt.Errorf("non 200 response: %d", statusCode)
+		return nonOKResponseErr(statusCode)
 	}
 
 	return nil
@@ -318,7 +318,7 @@ func (a *ASRockRack) upgradeBMC(ctx context.Context) error {
 	}
 
 	if statusCode != http.StatusOK {
-		return fmt.Errorf("non 200 response: %d", statusCode)
+		return nonOKResponseErr(statusCode)
 	}
 
 	return nil
@@ -332,7 +332,7 @@ func (a *ASRockRack) flashProgress(ctx context.Context, endpoint string) (*upgra
 	}
 
 	if statusCode != http.StatusOK {
-		return nil, fmt.Errorf("non 200 response: %d", statusCode)
+		return nil, nonOKResponseErr(statusCode)
 	}
 
 	p := &upgradeProgress{}
@@ -352,7 +352,7 @@ func (a *ASRockRack) firmwareInfo(ctx context.Context) (*firmwareInfo, error) {
 	}
 
 	if statusCode != http.StatusOK {
-		return nil, fmt.Errorf("non 200 response: %d", statusCode)
+		return nil, nonOKResponseErr(statusCode)
 	}
 
 	f := &firmwareInfo{}
@@ -372,7 +372,7 @@ func (a *ASRockRack) postCodeInfo(ctx context.Context) (*biosPOSTCode, error) {
 	}
 
 	if statusCode != http.StatusOK {
-		return nil, fmt.Errorf("non 200 response: %d", statusCode)
+		return nil, nonOKResponseErr(statusCode)
 	}
 
 	b := &biosPOSTCode{}
@@ -392,7 +392,7 @@ func (a *ASRockRack) inventoryInfoE3C246D41D(ctx context.Context) ([]*component,
 	}
 
 	if statusCode != http.StatusOK {
-		return nil, fmt.Errorf("non 200 response: %d", statusCode)
+		return nil, nonOKResponseErr(statusCode)
 	}
 
 	components := []*component{}
@@ -412,7 +412,7 @@ func (a *ASRockRack) fruInfo(ctx context.Context) ([]*fru, error) {
 	}
 
 	if statusCode != http.StatusOK {
-		return nil, fmt.Errorf("non 200 response: %d", statusCode)
+		return nil, nonOKResponseErr(statusCode)
 	}
 
 	data := []map[string]*fru{}
@@ -458,7 +458,7 @@ func (a *ASRockRack) sensors(ctx context.Context) ([]*sensor, error) {
 	}
 
 	if statusCode != http.StatusOK {
-		return nil, fmt.Errorf("non 200 response: %d", statusCode)
+		return nil, nonOKResponseErr(statusCode)
 	}
 
 	sensors := []*sensor{}
@@ -489,7 +489,7 @@ func (a *ASRockRack) biosUpgradeConfiguration(ctx context.Context) error {
 	}
 
 	if statusCode != http.StatusOK {
-		return fmt.Errorf("non 200 response: %d", statusCode)
+		return nonOKResponseErr(statusCode)
 	}
 
 	f := &firmwareInfo{}
@@ -514,7 +514,7 @@ func (a *ASRockRack) upgradeBIOS(ctx context.Context) error {
 	}
 
 	if statusCode != http.StatusOK {
-		return fmt.Errorf("non 200 response: %d", statusCode)
+		return nonOKResponseErr(statusCode)
 	}
 
 	f := &firmwareInfo{}
@@ -529,7 +529,7 @@ func (a *ASRockRack) chassisStatusInfo(ctx context.Context) (*chassisStatus, err
 	}
 
 	if statusCode != http.StatusOK {
-		return nil, fmt.Errorf("non 200 response: %d", statusCode)
+		return nil, nonOKResponseErr(statusCode)
 	}
 
 	chassisStatus := chassisStatus{}
@@ -561,7 +561,7 @@ func (a *ASRockRack) httpsLogin(ctx context.Context) error {
 	}
 
 	if statusCode == 401 {
-		return brrs.ErrLoginFailed
+		return brrs.NewHTTPError(statusCode, brrs.ErrLoginFailed)
 	}
 
 	// Unmarshal login session
@@ -587,6 +587,12 @@ func (a *ASRockRack) httpsLogout(ctx context.Context) error {
 	return nil
 }
 
+// nonOKResponseErr returns an error for an unexpected status code returned by the BMC,
+// the error carries the status code and its classification.
+func nonOKResponseErr(statusCode int) error {
+	return brrs.NewHTTPError(statusCode, fmt.Errorf("non 200 response: %d", statusCode))
+}
+
 // queryHTTPS run the HTTPS query passing in the required headers
 // the / suffix should be excluded from the URLendpoint
 // returns - response body, http status code, error if any
@@ -101,9 +101,9 @@ func (a *ASRockRack) powerAction(ctx context.Context, action int) (ok bool, err
 	}
 
 	if statusCode != http.StatusOK {
-		return false, errors.Wrap(
-			bmclibErrs.ErrNon200Response,
-			fmt.Errorf("%d", statusCode).Error(),
+		return false, bmclibErrs.NewHTTPError(
+			statusCode,
+			errors.Wrap(bmclibErrs.ErrNon200Response, fmt.Errorf("%d", statusCode).Error()),
 		)
 	}
 
@@ -131,7 +131,7 @@ func (a *ASRockRack) resetBMC(ctx context.Context) error {
 
 	// The E3C256D4ID BMC returns a 500 status error on the BMC reset request
 	if statusCode != http.StatusOK && statusCode != http.StatusInternalServerError {
-		return fmt.Errorf("non 200 response: %d", statusCode)
+		return nonOKResponseErr(statusCode)
 	}
 
 	return nil
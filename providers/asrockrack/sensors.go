@@ -0,0 +1,132 @@
+package asrockrack
+
+import (
+	"context"
+
+	"github.com/metal-toolbox/bmclib/bmc"
+)
+
+// sensorTypes maps the sensor types and units reported by the BMC to the sensor types and units
+var sensorTypes = map[string]struct {
+	sensorType bmc.SensorType
+	unit       string
+}{
+	"temperature": {bmc.SensorTypeTemperature, bmc.SensorUnitCelsius},
+	"fan":         {bmc.SensorTypeFan, bmc.SensorUnitRPM},
+	"voltage":     {bmc.SensorTypeVoltage, bmc.SensorUnitVolts},
+}
+
+// The bits of the readable thresholds in the sensor settable_readable_threshMask
+const (
+	threshLowerNonCritical = 1 << iota
+	threshLowerCritical
+	threshLowerNonRecoverable
+	threshUpperNonCritical
+	threshUpperCritical
+	threshUpperNonRecoverable
+)
+
+// The bits of the threshold sensor sensor_state, the normal bit is set for readings within the thresholds
+// and the bits following it, in the order of the threshold bits, are set for readings crossing a threshold.
+const (
+	stateNormal = 1 << iota
+	stateLowerNonCritical
+	stateLowerCritical
+	stateLowerNonRecoverable
+	stateUpperNonCritical
+	stateUpperCritical
+	stateUpperNonRecoverable
+)
+
+// sensorStates maps the sensor_state bits to the sensor statuses, from the most to the least severe
+var sensorStates = []struct {
+	bit    int
+	status bmc.SensorStatus
+}{
+	{stateLowerNonRecoverable, bmc.SensorStatusCritical},
+	{stateUpperNonRecoverable, bmc.SensorStatusCritical},
+	{stateLowerCritical, bmc.SensorStatusCritical},
+	{stateUpperCritical, bmc.SensorStatusCritical},
+	{stateLowerNonCritical, bmc.SensorStatusWarning},
+	{stateUpperNonCritical, bmc.SensorStatusWarning},
+	{stateNormal, bmc.SensorStatusOK},
+}
+
+// SensorsRead returns the temperature, fan and voltage sensor readings,
+// discrete sensors like the CPU_CATERR are returned without a value.
+func (a *ASRockRack) SensorsRead(ctx context.Context) ([]bmc.SensorReading, error) {
+	sensors, err := a.sensors(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	readings := make([]bmc.SensorReading, 0, len(sensors))
+	for _, s := range sensors {
+		readings = append(readings, sensorReading(s))
+	}
+
+	return readings, nil
+}
+
+func sensorReading(s *sensor) bmc.SensorReading {
+	t, ok := sensorTypes[s.Type]
+	if !ok {
+		// discrete sensors report a state bit mask as their reading
+		// and a sensor_state of zero when the state is normal.
+		status := bmc.SensorStatusOK
+		if s.SensorState != 0 {
+			status = bmc.SensorStatusCritical
+		}
+
+		return bmc.SensorReading{Name: s.Name, Type: bmc.SensorTypeOther, Status: status}
+	}
+
+	reading := bmc.SensorReading{
+		Name:   s.Name,
+		Type:   t.sensorType,
+		Unit:   t.unit,
+		Status: bmc.SensorStatusOK,
+	}
+
+	// sensors that are not present or not powered are reported as not accessible
+	if s.Accessible != 0 {
+		reading.Status = bmc.SensorStatusUnavailable
+		return reading
+	}
+
+	value := s.Reading
+	reading.Value = &value
+
+	reading.Status = thresholdSensorStatus(s.SensorState)
+
+	threshold := func(bit int, value float64) *float64 {
+		if s.SettableReadableThreshMask&bit == 0 {
+			return nil
+		}
+
+		return &value
+	}
+
+	reading.Thresholds = bmc.SensorThresholds{
+		LowerNonCritical:    threshold(threshLowerNonCritical, s.LowerNonCriticalThreshold),
+		LowerCritical:       threshold(threshLowerCritical, s.LowerCriticalThreshold),
+		LowerNonRecoverable: threshold(threshLowerNonRecoverable, s.LowerNonRecoverableThreshold),
+		UpperNonCritical:    threshold(threshUpperNonCritical, s.HigherNonCriticalThreshold),
+		UpperCritical:       threshold(threshUpperCritical, s.HigherCriticalThreshold),
+		UpperNonRecoverable: threshold(threshUpperNonRecoverable, s.HigherNonRecoverableThreshold),
+	}
+
+	return reading
+}
+
+// thresholdSensorStatus returns the status of the most severe sensor_state bit set,
+// or an unknown status when none of the known bits are set.
+func thresholdSensorStatus(state int) bmc.SensorStatus {
+	for _, s := range sensorStates {
+		if state&s.bit != 0 {
+			return s.status
+		}
+	}
+
+	return bmc.SensorStatusUnknown
+}
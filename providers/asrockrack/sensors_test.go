@@ -0,0 +1,72 @@
+package asrockrack
+
+import (
+	"context"
+	"testing"
+
+	"github.com/metal-toolbox/bmclib/bmc"
+	"gopkg.in/go-playground/assert.v1"
+)
+
+func TestSensorsRead(t *testing.T) {
+	err := aClient.httpsLogin(context.TODO())
+	if err != nil {
+		t.Errorf("login: %s", err.Error())
+	}
+
+	readings, err := aClient.SensorsRead(context.TODO())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	assert.Equal(t, 27, len(readings))
+
+	byName := map[string]bmc.SensorReading{}
+	for _, r := range readings {
+		byName[r.Name] = r
+	}
+
+	volts := byName["12V"]
+	assert.Equal(t, bmc.SensorTypeVoltage, volts.Type)
+	assert.Equal(t, bmc.SensorUnitVolts, volts.Unit)
+	assert.Equal(t, bmc.SensorStatusOK, volts.Status)
+	assert.Equal(t, 12.2, *volts.Value)
+	assert.Equal(t, 10.8, *volts.Thresholds.LowerCritical)
+	assert.Equal(t, 13.8, *volts.Thresholds.UpperNonRecoverable)
+	assert.Equal(t, true, volts.Thresholds.LowerNonCritical == nil)
+
+	cpu := byName["CPU Temp"]
+	assert.Equal(t, bmc.SensorTypeTemperature, cpu.Type)
+	assert.Equal(t, bmc.SensorUnitCelsius, cpu.Unit)
+	assert.Equal(t, 100.0, *cpu.Thresholds.UpperCritical)
+
+	// not accessible
+	tr1 := byName["TR1 Temp"]
+	assert.Equal(t, bmc.SensorStatusUnavailable, tr1.Status)
+	assert.Equal(t, true, tr1.Value == nil)
+
+	// discrete
+	caterr := byName["CPU_CATERR"]
+	assert.Equal(t, bmc.SensorTypeOther, caterr.Type)
+	assert.Equal(t, bmc.SensorStatusOK, caterr.Status)
+	assert.Equal(t, true, caterr.Value == nil)
+}
+
+func TestThresholdSensorStatus(t *testing.T) {
+	testCases := []struct {
+		state int
+		want  bmc.SensorStatus
+	}{
+		{stateNormal, bmc.SensorStatusOK},
+		{stateLowerNonCritical, bmc.SensorStatusWarning},
+		{stateUpperNonCritical, bmc.SensorStatusWarning},
+		{stateUpperCritical, bmc.SensorStatusCritical},
+		{stateUpperNonCritical | stateUpperCritical, bmc.SensorStatusCritical},
+		{stateLowerNonRecoverable, bmc.SensorStatusCritical},
+		{0, bmc.SensorStatusUnknown},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.want, thresholdSensorStatus(tc.state))
+	}
+}
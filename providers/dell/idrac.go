@@ -9,10 +9,12 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/jacobweinstock/registrar"
 	common "github.com/metal-toolbox/bmc-common"
+	"github.com/metal-toolbox/bmclib/bmc"
 	"github.com/metal-toolbox/bmclib/internal/httpclient"
 	"github.com/metal-toolbox/bmclib/internal/racadm"
 	"github.com/metal-toolbox/bmclib/internal/redfishwrapper"
@@ -48,6 +50,13 @@ var (
 		providers.FeatureSetBiosConfiguration,
 		providers.FeatureSetBiosConfigurationFromFile,
 		providers.FeatureResetBiosConfiguration,
+		providers.FeaturePowerRead,
+		providers.FeaturePowerCapSet,
+		providers.FeaturePowerRestorePolicy,
+		providers.FeatureIdentify,
+		providers.FeatureLastRestart,
+		providers.FeatureSensorsRead,
+		providers.FeatureLogServices,
 	}
 
 	errManufacturerUnknown = errors.New("error identifying device manufacturer")
@@ -256,6 +265,92 @@ func (c *Conn) SendNMI(ctx context.Context) error {
 	return c.redfishwrapper.SendNMI(ctx)
 }
 
+// PowerRead returns the power consumption and power limit of the machine
+func (c *Conn) PowerRead(ctx context.Context) (bmc.PowerReading, error) {
+	return c.redfishwrapper.PowerRead(ctx)
+}
+
+// PowerCapSet sets the power limit of the machine, a limit of zero removes the power limit
+func (c *Conn) PowerCapSet(ctx context.Context, watts int) error {
+	return c.redfishwrapper.PowerCapSet(ctx, watts)
+}
+
+// IdentifyGet returns the state of the system identify LED
+func (c *Conn) IdentifyGet(ctx context.Context) (bmc.IdentifyState, error) {
+	return c.redfishwrapper.IdentifyGet(ctx)
+}
+
+// IdentifySet sets the state of the system identify LED
+func (c *Conn) IdentifySet(ctx context.Context, state bmc.IdentifyState, duration time.Duration) error {
+	return c.redfishwrapper.IdentifySet(ctx, state, duration)
+}
+
+// LastRestart returns the cause and time of the last system restart
+func (c *Conn) LastRestart(ctx context.Context) (bmc.RestartInfo, error) {
+	return c.redfishwrapper.LastRestart(ctx)
+}
+
+// SensorsRead returns the temperature, fan and voltage sensor readings
+func (c *Conn) SensorsRead(ctx context.Context) ([]bmc.SensorReading, error) {
+	return c.redfishwrapper.SensorsRead(ctx)
+}
+
+// LogServices returns the system, iDRAC and chassis log services, including the Lifecycle Controller log
+func (c *Conn) LogServices(ctx context.Context) ([]bmc.LogServiceInfo, error) {
+	return c.redfishwrapper.LogServices(ctx)
+}
+
+// GetLogServiceEntries returns the entries of the given log service, like Lclog or Sel
+func (c *Conn) GetLogServiceEntries(ctx context.Context, id string) ([]bmc.SystemEventLogEntry, error) {
+	return c.redfishwrapper.GetLogServiceEntries(ctx, id)
+}
+
+// ClearLogService clears the given log service
+func (c *Conn) ClearLogService(ctx context.Context, id string) error {
+	return c.redfishwrapper.ClearLogService(ctx, id)
+}
+
+// acPowerRecoveryAttribute is the BIOS attribute holding the system power state after an AC power loss.
+const acPowerRecoveryAttribute = "AcPwrRcvry"
+
+// acPowerRecoveryValues maps the power restore policies to the AcPwrRcvry BIOS attribute values
+var acPowerRecoveryValues = map[bmc.PowerRestorePolicy]string{
+	bmc.PowerRestoreAlwaysOn:  "On",
+	bmc.PowerRestoreAlwaysOff: "Off",
+	bmc.PowerRestoreLastState: "Last",
+}
+
+// PowerRestorePolicyGet returns the power restore policy from the AcPwrRcvry BIOS attribute
+func (c *Conn) PowerRestorePolicyGet(ctx context.Context) (bmc.PowerRestorePolicy, error) {
+	biosConfig, err := c.redfishwrapper.GetBiosConfiguration(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	value, ok := biosConfig[acPowerRecoveryAttribute]
+	if !ok {
+		return "", errors.Wrap(bmclibErrs.ErrNoBiosAttributes, acPowerRecoveryAttribute)
+	}
+
+	for policy, v := range acPowerRecoveryValues {
+		if strings.EqualFold(v, value) {
+			return policy, nil
+		}
+	}
+
+	return "", fmt.Errorf("unknown %s value: %q", acPowerRecoveryAttribute, value)
+}
+
+// PowerRestorePolicySet sets the AcPwrRcvry BIOS attribute, the change is applied on the next system reset
+func (c *Conn) PowerRestorePolicySet(ctx context.Context, policy bmc.PowerRestorePolicy) error {
+	value, ok := acPowerRecoveryValues[policy]
+	if !ok {
+		return fmt.Errorf("unknown power restore policy: %q", policy)
+	}
+
+	return c.redfishwrapper.SetBiosConfiguration(ctx, map[string]string{acPowerRecoveryAttribute: value})
+}
+
 // deviceManufacturer returns the device manufacturer and model attributes
 func (c *Conn) deviceManufacturer() (vendor string, err error) {
 	systems, err := c.redfishwrapper.Systems()
@@ -313,3 +408,13 @@ func (c *Conn) Screenshot(ctx context.Context) (image []byte, fileType string, e
 
 	return image, fileType, nil
 }
+
+// ProbeCapabilities discovers the features supported by the iDRAC Redfish service
+func (c *Conn) ProbeCapabilities(ctx context.Context) (bmc.Capabilities, error) {
+	caps, err := c.redfishwrapper.ProbeServiceCapabilities(ctx)
+	if err != nil {
+		return bmc.Capabilities{}, err
+	}
+
+	return caps.Capabilities(Features), nil
+}
@@ -0,0 +1,62 @@
+package providers
+
+import (
+	"net/http"
+
+	"github.com/go-logr/logr"
+	"github.com/jacobweinstock/registrar"
+)
+
+// FactoryConfig holds the connection details passed to a Factory to construct a provider.
+type FactoryConfig struct {
+	Host string
+	User string
+	Pass string
+	// HTTPClient is a copy of the bmclib Client http client, including any TLS configuration.
+	HTTPClient *http.Client
+	Logger     logr.Logger
+}
+
+// Factory constructs a provider that is registered with the bmclib Client,
+// it allows providers that are not built into bmclib to be used alongside the built-in providers.
+//
+// The provider returned by New is expected to implement the bmc package interfaces
+// for the features it declares, along with the Name() method.
+type Factory interface {
+	// Name is the provider name, used by the Client filter methods like For and PreferProvider.
+	Name() string
+	// Protocol is the protocol the provider uses to communicate with the BMC, like redfish or ipmi.
+	Protocol() string
+	// Features are the features the provider implements.
+	Features() registrar.Features
+	// New returns the provider for the BMC in the config.
+	New(config FactoryConfig) (interface{}, error)
+}
+
+// NewFactory returns a Factory for the given provider details and constructor.
+func NewFactory(name, protocol string, features registrar.Features, constructor func(FactoryConfig) (interface{}, error)) Factory {
+	return &factory{name: name, protocol: protocol, features: features, constructor: constructor}
+}
+
+type factory struct {
+	name        string
+	protocol    string
+	features    registrar.Features
+	constructor func(FactoryConfig) (interface{}, error)
+}
+
+func (f *factory) Name() string {
+	return f.name
+}
+
+func (f *factory) Protocol() string {
+	return f.protocol
+}
+
+func (f *factory) Features() registrar.Features {
+	return f.features
+}
+
+func (f *factory) New(config FactoryConfig) (interface{}, error) {
+	return f.constructor(config)
+}
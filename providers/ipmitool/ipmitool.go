@@ -3,10 +3,14 @@ package ipmitool
 import (
 	"context"
 	"errors"
+	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/jacobweinstock/registrar"
+	"github.com/metal-toolbox/bmclib/bmc"
 	bmclibErrs "github.com/metal-toolbox/bmclib/errors"
 	"github.com/metal-toolbox/bmclib/internal/ipmi"
 	"github.com/metal-toolbox/bmclib/providers"
@@ -31,6 +35,13 @@ var (
 		providers.FeatureGetSystemEventLog,
 		providers.FeatureGetSystemEventLogRaw,
 		providers.FeatureDeactivateSOL,
+		providers.FeaturePowerRead,
+		providers.FeaturePowerCapSet,
+		providers.FeaturePowerRestorePolicy,
+		providers.FeatureIdentify,
+		providers.FeatureLastRestart,
+		providers.FeatureSensorsRead,
+		providers.FeatureGetSystemEventLogEntries,
 	}
 )
 
@@ -194,8 +205,77 @@ func (c *Conn) ClearSystemEventLog(ctx context.Context) (err error) {
 	return c.ipmitool.ClearSystemEventLog(ctx)
 }
 
+// GetSystemEventLog returns the System Event Log entries in the ID, date time, type #number, event : direction column format
 func (c *Conn) GetSystemEventLog(ctx context.Context) (entries [][]string, err error) {
-	return c.ipmitool.GetSystemEventLog(ctx)
+	sel, err := c.ipmitool.GetSystemEventLog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range sel {
+		entries = append(entries, e.Columns())
+	}
+
+	return entries, nil
+}
+
+// selSeverities map the sel event descriptions to a severity, the first match wins so that
+// "non-critical" is matched before "critical" and "uncorrectable" before "correctable"
+var selSeverities = []struct {
+	pattern  string
+	severity bmc.SystemEventLogSeverity
+}{
+	{"non-recoverable", bmc.SystemEventLogSeverityCritical},
+	{"non-critical", bmc.SystemEventLogSeverityWarning},
+	{"critical", bmc.SystemEventLogSeverityCritical},
+	{"uncorrectable", bmc.SystemEventLogSeverityCritical},
+	{"failure", bmc.SystemEventLogSeverityCritical},
+	{"fault", bmc.SystemEventLogSeverityCritical},
+	{"correctable", bmc.SystemEventLogSeverityWarning},
+	{"predictive", bmc.SystemEventLogSeverityWarning},
+}
+
+// GetSystemEventLogEntries returns the System Event Log entries, IPMI doesn't report a severity, it is derived
+// from the event description with deasserted events taken as informational.
+func (c *Conn) GetSystemEventLogEntries(ctx context.Context) ([]bmc.SystemEventLogEntry, error) {
+	sel, err := c.ipmitool.GetSystemEventLog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]bmc.SystemEventLogEntry, 0, len(sel))
+	for _, e := range sel {
+		entry := bmc.SystemEventLogEntry{
+			ID:           e.ID,
+			Timestamp:    e.Timestamp,
+			Severity:     bmc.SystemEventLogSeverityInfo,
+			SensorType:   e.SensorType,
+			SensorNumber: e.SensorNumber,
+			Message:      e.Event,
+			Raw:          e.Raw,
+		}
+
+		switch e.Direction {
+		case "Asserted":
+			entry.Direction = bmc.SystemEventAsserted
+		case "Deasserted":
+			entry.Direction = bmc.SystemEventDeasserted
+		}
+
+		if entry.Direction != bmc.SystemEventDeasserted {
+			event := strings.ToLower(e.Event)
+			for _, s := range selSeverities {
+				if strings.Contains(event, s.pattern) {
+					entry.Severity = s.severity
+					break
+				}
+			}
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
 }
 
 func (c *Conn) GetSystemEventLogRaw(ctx context.Context) (eventlog string, err error) {
@@ -206,3 +286,283 @@ func (c *Conn) GetSystemEventLogRaw(ctx context.Context) (eventlog string, err e
 func (c *Conn) SendNMI(ctx context.Context) error {
 	return c.ipmitool.SendPowerDiag(ctx)
 }
+
+// PowerRead returns the DCMI power reading and power limit
+func (c *Conn) PowerRead(ctx context.Context) (bmc.PowerReading, error) {
+	reading, err := c.ipmitool.PowerReading(ctx)
+	if err != nil {
+		return bmc.PowerReading{}, err
+	}
+
+	result := bmc.PowerReading{
+		Watts:        float64(reading.Instantaneous),
+		MinWatts:     float64(reading.Minimum),
+		MaxWatts:     float64(reading.Maximum),
+		AverageWatts: float64(reading.Average),
+		Interval:     time.Duration(reading.SamplingPeriod) * time.Second,
+	}
+
+	// BMCs may implement the DCMI power reading without the power limit commands,
+	// the reading is returned without the power limit in that case.
+	limit, err := c.ipmitool.PowerLimit(ctx)
+	if err != nil {
+		c.log.V(1).Info("power limit not available", "error", err.Error())
+		return result, nil
+	}
+
+	result.CapWatts = float64(limit.Watts)
+	result.CapEnabled = limit.Active
+
+	return result, nil
+}
+
+// PowerCapSet sets and activates the DCMI power limit, a limit of zero deactivates the power limit
+func (c *Conn) PowerCapSet(ctx context.Context, watts int) error {
+	return c.ipmitool.SetPowerLimit(ctx, watts)
+}
+
+// ipmitoolRestorePolicies maps the power restore policies to the ipmitool chassis policy names
+var ipmitoolRestorePolicies = map[bmc.PowerRestorePolicy]string{
+	bmc.PowerRestoreAlwaysOn:  "always-on",
+	bmc.PowerRestoreAlwaysOff: "always-off",
+	bmc.PowerRestoreLastState: "previous",
+}
+
+// PowerRestorePolicyGet returns the chassis power restore policy
+func (c *Conn) PowerRestorePolicyGet(ctx context.Context) (bmc.PowerRestorePolicy, error) {
+	policy, err := c.ipmitool.PowerRestorePolicy(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return bmc.ParsePowerRestorePolicy(policy)
+}
+
+// PowerRestorePolicySet sets the chassis power restore policy
+func (c *Conn) PowerRestorePolicySet(ctx context.Context, policy bmc.PowerRestorePolicy) error {
+	name, ok := ipmitoolRestorePolicies[policy]
+	if !ok {
+		return fmt.Errorf("unknown power restore policy: %q", policy)
+	}
+
+	return c.ipmitool.SetPowerRestorePolicy(ctx, name)
+}
+
+// maxIdentifyInterval is the longest interval chassis identify accepts
+const maxIdentifyInterval = 255 * time.Second
+
+// IdentifySet sets the chassis identify LED, IPMI doesn't tell a lit LED apart from a blinking one.
+// The LED stays on until turned off when no duration is given.
+func (c *Conn) IdentifySet(ctx context.Context, state bmc.IdentifyState, duration time.Duration) error {
+	switch {
+	case state == bmc.IdentifyOff:
+		return c.ipmitool.ChassisIdentify(ctx, "0")
+	case duration == 0:
+		return c.ipmitool.ChassisIdentify(ctx, "force")
+	case duration > maxIdentifyInterval:
+		return fmt.Errorf("identify duration %s exceeds the %s chassis identify limit", duration, maxIdentifyInterval)
+	default:
+		// round up, an interval of zero would turn the LED off
+		seconds := (duration + time.Second - 1) / time.Second
+		return c.ipmitool.ChassisIdentify(ctx, fmt.Sprint(int(seconds)))
+	}
+}
+
+// IdentifyGet returns the chassis identify LED state reported by chassis status,
+// a timed identify is reported as IdentifyOn since IPMI has no blinking state.
+// An error classified as unsupported is returned when the BMC doesn't report the state, which is optional in IPMI.
+func (c *Conn) IdentifyGet(ctx context.Context) (bmc.IdentifyState, error) {
+	state, err := c.ipmitool.ChassisIdentifyState(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	switch strings.ToLower(state) {
+	case "":
+		return "", fmt.Errorf("%w: the BMC does not report the chassis identify state", bmclibErrs.ErrNotImplemented)
+	case "off":
+		return bmc.IdentifyOff, nil
+	case "temporary (timed) on", "indefinite on":
+		return bmc.IdentifyOn, nil
+	default:
+		return "", fmt.Errorf("unknown chassis identify state: %q", state)
+	}
+}
+
+// restartCauses maps the ipmitool chassis restart_cause descriptions to the restart causes
+var restartCauses = map[string]bmc.RestartCause{
+	"unknown":                       bmc.RestartCauseUnknown,
+	"chassis power control command": bmc.RestartCauseRemoteCommand,
+	"reset via pushbutton":          bmc.RestartCauseResetButton,
+	"power-up via pushbutton":       bmc.RestartCausePowerButton,
+	"watchdog expired":              bmc.RestartCauseWatchdog,
+	"OEM":                           bmc.RestartCauseOEM,
+	"power-up due to always-restore power policy":   bmc.RestartCausePowerRestore,
+	"power-up due to restore-previous power policy": bmc.RestartCausePowerRestore,
+	"reset via PEF":           bmc.RestartCausePEF,
+	"power-cycle via PEF":     bmc.RestartCausePEF,
+	"soft reset":              bmc.RestartCauseSoftReset,
+	"power-up via RTC wakeup": bmc.RestartCauseRTCWakeup,
+}
+
+// LastRestart returns the chassis restart cause and power on hours, IPMI doesn't report the restart time
+func (c *Conn) LastRestart(ctx context.Context) (bmc.RestartInfo, error) {
+	detail, err := c.ipmitool.RestartCause(ctx)
+	if err != nil {
+		return bmc.RestartInfo{}, err
+	}
+
+	info := bmc.RestartInfo{Cause: bmc.RestartCauseUnknown, Detail: detail}
+	if cause, ok := restartCauses[detail]; ok {
+		info.Cause = cause
+	}
+
+	// the power on hours counter is optional in IPMI
+	info.PowerOnHours, err = c.ipmitool.PowerOnHours(ctx)
+	if err != nil {
+		c.log.V(1).Info("power on hours not available", "error", err.Error())
+	}
+
+	return info, nil
+}
+
+// sensorUnits maps the ipmitool sdr reading units to the sensor types and units
+var sensorUnits = map[string]struct {
+	sensorType bmc.SensorType
+	unit       string
+}{
+	"degrees C": {bmc.SensorTypeTemperature, bmc.SensorUnitCelsius},
+	"RPM":       {bmc.SensorTypeFan, bmc.SensorUnitRPM},
+	"Volts":     {bmc.SensorTypeVoltage, bmc.SensorUnitVolts},
+	"Amps":      {bmc.SensorTypeCurrent, bmc.SensorUnitAmps},
+	"Watts":     {bmc.SensorTypePower, bmc.SensorUnitWatts},
+	"percent":   {bmc.SensorTypeOther, bmc.SensorUnitPercent},
+}
+
+// sensorStatuses maps the ipmitool sdr status codes to the sensor statuses
+var sensorStatuses = map[string]bmc.SensorStatus{
+	"ok":  bmc.SensorStatusOK,
+	"nc":  bmc.SensorStatusWarning,
+	"lnc": bmc.SensorStatusWarning,
+	"unc": bmc.SensorStatusWarning,
+	"cr":  bmc.SensorStatusCritical,
+	"lcr": bmc.SensorStatusCritical,
+	"ucr": bmc.SensorStatusCritical,
+	"nr":  bmc.SensorStatusCritical,
+	"lnr": bmc.SensorStatusCritical,
+	"unr": bmc.SensorStatusCritical,
+	"ns":  bmc.SensorStatusUnavailable,
+}
+
+// SensorsRead returns the readings of the sensors in the SDR repository, with the thresholds taken from
+// the sensor list since sdr elist doesn't report them. Discrete sensors are returned without a value.
+// The readings are returned without thresholds when the sensor list is not available.
+func (c *Conn) SensorsRead(ctx context.Context) ([]bmc.SensorReading, error) {
+	sdr, err := c.ipmitool.SensorReadings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// sensor list reads each sensor and is slower and less reliable than sdr elist, the thresholds are optional
+	sensors, err := c.ipmitool.SensorThresholds(ctx)
+	if err != nil {
+		c.log.V(1).Info("sensor thresholds not available", "error", err.Error())
+	}
+
+	thresholds := make(map[string]bmc.SensorThresholds, len(sensors))
+	for _, s := range sensors {
+		// sensors sharing a name keep the thresholds of the first one listed
+		if _, exists := thresholds[s.Name]; exists {
+			continue
+		}
+
+		thresholds[s.Name] = bmc.SensorThresholds{
+			LowerNonCritical:    s.LowerNonCritical,
+			LowerCritical:       s.LowerCritical,
+			LowerNonRecoverable: s.LowerNonRecoverable,
+			UpperNonCritical:    s.UpperNonCritical,
+			UpperCritical:       s.UpperCritical,
+			UpperNonRecoverable: s.UpperNonRecoverable,
+		}
+	}
+
+	readings := make([]bmc.SensorReading, 0, len(sdr))
+	for _, s := range sdr {
+		reading := bmc.SensorReading{
+			Name:       s.Name,
+			Type:       bmc.SensorTypeOther,
+			Value:      s.Value,
+			Unit:       s.Unit,
+			Thresholds: thresholds[s.Name],
+			Status:     bmc.SensorStatusUnknown,
+		}
+
+		if unit, ok := sensorUnits[s.Unit]; ok {
+			reading.Type = unit.sensorType
+			reading.Unit = unit.unit
+		}
+
+		if status, ok := sensorStatuses[s.Status]; ok {
+			reading.Status = status
+		}
+
+		readings = append(readings, reading)
+	}
+
+	return readings, nil
+}
+
+// deviceFeatures are the features that depend on a device listed in the mc info Additional Device Support.
+var deviceFeatures = map[string]registrar.Features{
+	"Chassis Device": {
+		providers.FeaturePowerSet,
+		providers.FeaturePowerState,
+		providers.FeatureBootDeviceSet,
+		providers.FeaturePowerRestorePolicy,
+		providers.FeatureIdentify,
+		providers.FeatureLastRestart,
+	},
+	"SEL Device": {
+		providers.FeatureClearSystemEventLog,
+		providers.FeatureGetSystemEventLog,
+		providers.FeatureGetSystemEventLogRaw,
+		providers.FeatureGetSystemEventLogEntries,
+	},
+	"Sensor Device": {
+		providers.FeatureSensorsRead,
+	},
+}
+
+// ProbeCapabilities discovers the features supported by the BMC from the devices listed by mc info
+func (c *Conn) ProbeCapabilities(ctx context.Context) (bmc.Capabilities, error) {
+	info, err := c.ipmitool.McInfo(ctx)
+	if err != nil {
+		return bmc.Capabilities{}, err
+	}
+
+	caps := bmc.Capabilities{Details: info.Fields}
+	caps.Details["Additional Device Support"] = strings.Join(info.AdditionalDeviceSupport, ",")
+
+	unsupported := map[registrar.Feature]bool{}
+	// BMCs that don't list any device can't be probed, all features are assumed supported.
+	if len(info.AdditionalDeviceSupport) > 0 {
+		for device, features := range deviceFeatures {
+			if !slices.Contains(info.AdditionalDeviceSupport, device) {
+				for _, f := range features {
+					unsupported[f] = true
+				}
+			}
+		}
+	}
+
+	for _, f := range Features {
+		if unsupported[f] {
+			caps.Unsupported = append(caps.Unsupported, f)
+			continue
+		}
+
+		caps.Features = append(caps.Features, f)
+	}
+
+	return caps, nil
+}
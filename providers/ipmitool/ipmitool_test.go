@@ -2,13 +2,17 @@ package ipmitool
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"testing"
 
+	"github.com/metal-toolbox/bmclib/bmc"
+	bmclibErrs "github.com/metal-toolbox/bmclib/errors"
 	"github.com/metal-toolbox/bmclib/logging"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestMain(m *testing.M) {
@@ -196,3 +200,73 @@ func TestSendNMI(t *testing.T) {
 	t.Log("NMI sent")
 	t.Fatal()
 }
+
+// fakeConn returns a Conn running the given shell script in place of ipmitool, the ipmitool arguments are in $@
+func fakeConn(t *testing.T, script string) *Conn {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "ipmitool")
+	if err := os.WriteFile(path, []byte("#!/bin/sh\n"+script), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := New("127.0.0.1", "ADMIN", "ADMIN", WithIpmitoolPath(path), WithCipherSuite("17"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return c
+}
+
+func TestIdentifyGet(t *testing.T) {
+	tests := map[string]struct {
+		state           string
+		want            bmc.IdentifyState
+		wantUnsupported bool
+	}{
+		"off":        {state: "Chassis Identify State : Off", want: bmc.IdentifyOff},
+		"timed":      {state: "Chassis Identify State : Temporary (timed) On", want: bmc.IdentifyOn},
+		"indefinite": {state: "Chassis Identify State : Indefinite On", want: bmc.IdentifyOn},
+		"not reported": {
+			wantUnsupported: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := fakeConn(t, fmt.Sprintf("echo 'System Power         : on'\necho '%s'\necho 'Front-Panel Lockout  : inactive'\n", tc.state))
+
+			state, err := c.IdentifyGet(context.Background())
+			if tc.wantUnsupported {
+				assert.True(t, errors.Is(err, bmclibErrs.ErrNotImplemented))
+				assert.Equal(t, bmclibErrs.ErrorClassUnsupported, bmclibErrs.Classify(err))
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.want, state)
+		})
+	}
+}
+
+func TestSensorsReadWithoutThresholds(t *testing.T) {
+	script := `case "$*" in
+*"sdr elist"*)
+	echo 'CPU Temp         | 01h | ok  |  3.1 | 40 degrees C'
+	;;
+*"sensor list"*)
+	echo 'Error: timeout reading sensor' >&2
+	exit 1
+	;;
+esac
+`
+	readings, err := fakeConn(t, script).SensorsRead(context.Background())
+	assert.Nil(t, err)
+
+	temp := 40.0
+	want := []bmc.SensorReading{
+		{Name: "CPU Temp", Type: bmc.SensorTypeTemperature, Value: &temp, Unit: bmc.SensorUnitCelsius, Status: bmc.SensorStatusOK},
+	}
+
+	assert.Equal(t, want, readings)
+}
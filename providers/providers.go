@@ -78,4 +78,28 @@ const (
 
 	// FeatureBootProgress indicates that the implementation supports reading the BootProgress from the BMC
 	FeatureBootProgress registrar.Feature = "bootprogress"
+
+	// FeaturePowerRead means an implementation that returns the power consumption and power limit of the machine
+	FeaturePowerRead registrar.Feature = "powerread"
+
+	// FeaturePowerCapSet means an implementation that can set the power limit of the machine
+	FeaturePowerCapSet registrar.Feature = "powercapset"
+
+	// FeaturePowerRestorePolicy means an implementation that can get and set the power state restored after an AC power loss
+	FeaturePowerRestorePolicy registrar.Feature = "powerrestorepolicy"
+
+	// FeatureIdentify means an implementation that can turn the chassis identify LED on and off
+	FeatureIdentify registrar.Feature = "identify"
+
+	// FeatureLastRestart means an implementation that returns the cause and time of the last system restart
+	FeatureLastRestart registrar.Feature = "lastrestart"
+
+	// FeatureSensorsRead means an implementation that returns the readings of the temperature, fan and voltage sensors
+	FeatureSensorsRead registrar.Feature = "sensorsread"
+
+	// FeatureGetSystemEventLogEntries means an implementation that returns the BMC System Event Log (SEL) as typed entries
+	FeatureGetSystemEventLogEntries registrar.Feature = "getsystemeventlogentries"
+
+	// FeatureLogServices means an implementation that lists the BMC log services and reads or clears a selected one
+	FeatureLogServices registrar.Feature = "logservices"
 )
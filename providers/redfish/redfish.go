@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/x509"
 	"net/http"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/jacobweinstock/registrar"
@@ -39,6 +40,14 @@ var (
 		providers.FeatureGetBiosConfiguration,
 		providers.FeatureSetBiosConfiguration,
 		providers.FeatureResetBiosConfiguration,
+		providers.FeaturePowerRead,
+		providers.FeaturePowerCapSet,
+		providers.FeaturePowerRestorePolicy,
+		providers.FeatureIdentify,
+		providers.FeatureLastRestart,
+		providers.FeatureSensorsRead,
+		providers.FeatureGetSystemEventLogEntries,
+		providers.FeatureLogServices,
 	}
 )
 
@@ -244,3 +253,53 @@ func (c *Conn) ResetBiosConfiguration(ctx context.Context) (err error) {
 func (c *Conn) SendNMI(ctx context.Context) error {
 	return c.redfishwrapper.SendNMI(ctx)
 }
+
+// PowerRead returns the power consumption and power limit of the machine
+func (c *Conn) PowerRead(ctx context.Context) (bmc.PowerReading, error) {
+	return c.redfishwrapper.PowerRead(ctx)
+}
+
+// PowerCapSet sets the power limit of the machine, a limit of zero removes the power limit
+func (c *Conn) PowerCapSet(ctx context.Context, watts int) error {
+	return c.redfishwrapper.PowerCapSet(ctx, watts)
+}
+
+// PowerRestorePolicyGet returns the power state restored after an AC power loss
+func (c *Conn) PowerRestorePolicyGet(ctx context.Context) (bmc.PowerRestorePolicy, error) {
+	return c.redfishwrapper.PowerRestorePolicyGet(ctx)
+}
+
+// PowerRestorePolicySet sets the power state restored after an AC power loss
+func (c *Conn) PowerRestorePolicySet(ctx context.Context, policy bmc.PowerRestorePolicy) error {
+	return c.redfishwrapper.PowerRestorePolicySet(ctx, policy)
+}
+
+// IdentifyGet returns the state of the system identify LED
+func (c *Conn) IdentifyGet(ctx context.Context) (bmc.IdentifyState, error) {
+	return c.redfishwrapper.IdentifyGet(ctx)
+}
+
+// IdentifySet sets the state of the system identify LED
+func (c *Conn) IdentifySet(ctx context.Context, state bmc.IdentifyState, duration time.Duration) error {
+	return c.redfishwrapper.IdentifySet(ctx, state, duration)
+}
+
+// LastRestart returns the cause and time of the last system restart
+func (c *Conn) LastRestart(ctx context.Context) (bmc.RestartInfo, error) {
+	return c.redfishwrapper.LastRestart(ctx)
+}
+
+// SensorsRead returns the temperature, fan and voltage sensor readings
+func (c *Conn) SensorsRead(ctx context.Context) ([]bmc.SensorReading, error) {
+	return c.redfishwrapper.SensorsRead(ctx)
+}
+
+// ProbeCapabilities discovers the features supported by the Redfish service
+func (c *Conn) ProbeCapabilities(ctx context.Context) (bmc.Capabilities, error) {
+	caps, err := c.redfishwrapper.ProbeServiceCapabilities(ctx)
+	if err != nil {
+		return bmc.Capabilities{}, err
+	}
+
+	return caps.Capabilities(Features), nil
+}
@@ -1,15 +1,37 @@
 package redfish
 
-import "context"
+import (
+	"context"
 
+	"github.com/metal-toolbox/bmclib/bmc"
+)
+
+// ClearSystemEventLog clears the Chassis LogServices, which are not the Manager LogServices read by GetSystemEventLog
 func (c *Conn) ClearSystemEventLog(ctx context.Context) (err error) {
 	return c.redfishwrapper.ClearSystemEventLog(ctx)
 }
 
+// GetSystemEventLog returns the entries of the Manager LogServices
 func (c *Conn) GetSystemEventLog(ctx context.Context) (entries [][]string, err error) {
 	return c.redfishwrapper.GetSystemEventLog(ctx)
 }
 
+func (c *Conn) GetSystemEventLogEntries(ctx context.Context) (entries []bmc.SystemEventLogEntry, err error) {
+	return c.redfishwrapper.GetSystemEventLogEntries(ctx)
+}
+
 func (c *Conn) GetSystemEventLogRaw(ctx context.Context) (eventlog string, err error) {
 	return c.redfishwrapper.GetSystemEventLogRaw(ctx)
 }
+
+func (c *Conn) LogServices(ctx context.Context) (services []bmc.LogServiceInfo, err error) {
+	return c.redfishwrapper.LogServices(ctx)
+}
+
+func (c *Conn) GetLogServiceEntries(ctx context.Context, id string) (entries []bmc.SystemEventLogEntry, err error) {
+	return c.redfishwrapper.GetLogServiceEntries(ctx, id)
+}
+
+func (c *Conn) ClearLogService(ctx context.Context, id string) (err error) {
+	return c.redfishwrapper.ClearLogService(ctx, id)
+}
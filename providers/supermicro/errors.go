@@ -5,10 +5,13 @@ import (
 	"strconv"
 
 	"github.com/pkg/errors"
+
+	bmclibErrs "github.com/metal-toolbox/bmclib/errors"
 )
 
 var (
 	ErrQueryFRUInfo      = errors.New("FRU information query returned error")
+	ErrQueryPowerInfo    = errors.New("power information query returned error")
 	ErrXMLAPIUnsupported = errors.New("XML API is unsupported")
 	ErrModelUnknown      = errors.New("Model number unknown")
 	ErrModelUnsupported  = errors.New("Model not supported")
@@ -31,10 +34,15 @@ func (e *UnexpectedResponseError) Error() string {
 	)
 }
 
+// unexpectedResponseErr returns an UnexpectedResponseError wrapped in a ProviderError
+// which carries the status code and its classification, errors.As can be used to retrieve either.
 func unexpectedResponseErr(payload, response []byte, statusCode int) error {
-	return &UnexpectedResponseError{
-		string(payload),
-		string(response),
-		strconv.Itoa(statusCode),
-	}
+	return bmclibErrs.NewHTTPError(
+		statusCode,
+		&UnexpectedResponseError{
+			string(payload),
+			string(response),
+			strconv.Itoa(statusCode),
+		},
+	)
 }
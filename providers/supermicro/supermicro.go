@@ -12,11 +12,13 @@ import (
 	"net/url"
 	"os"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	common "github.com/metal-toolbox/bmc-common"
+	"github.com/metal-toolbox/bmclib/bmc"
 	"github.com/metal-toolbox/bmclib/constants"
 	"github.com/metal-toolbox/bmclib/internal/httpclient"
 	"github.com/metal-toolbox/bmclib/internal/redfishwrapper"
@@ -58,6 +60,8 @@ var (
 		providers.FeatureSetBiosConfigurationFromFile,
 		providers.FeatureResetBiosConfiguration,
 		providers.FeatureBootProgress,
+		providers.FeaturePowerRead,
+		providers.FeaturePowerCapSet,
 	}
 )
 
@@ -124,6 +128,9 @@ type bmcQueryor interface {
 	supportsInstall(component string) error
 	getBootProgress() (*redfish.BootProgress, error)
 	bootComplete() (bool, error)
+	// power readings and power limit through the ipmi.cgi XML API
+	powerRead(ctx context.Context) (bmc.PowerReading, error)
+	powerCapSet(ctx context.Context, watts int) error
 }
 
 // New returns connection with a Supermicro client initialized
@@ -233,6 +240,63 @@ func (c *Client) PowerSet(ctx context.Context, state string) (ok bool, err error
 	return c.serviceClient.redfish.PowerSet(ctx, state)
 }
 
+// PowerRead returns the power consumption and power limit of the machine.
+//
+// The readings are taken through the ipmi.cgi XML API, on the X12 and X13 models which lack the XML API,
+// or when the XML API query fails, they are taken from the Redfish Chassis Power resource instead.
+func (c *Client) PowerRead(ctx context.Context) (bmc.PowerReading, error) {
+	if c.serviceClient == nil || c.serviceClient.redfish == nil || c.bmc == nil {
+		return bmc.PowerReading{}, errors.Wrap(bmclibErrs.ErrLoginFailed, "client not initialized")
+	}
+
+	reading, err := c.bmc.powerRead(ctx)
+	if err == nil {
+		return reading, nil
+	}
+
+	c.logRedfishFallback("PowerRead", err)
+
+	reading, rerr := c.serviceClient.redfish.PowerRead(ctx)
+	if rerr != nil {
+		return bmc.PowerReading{}, errors.Wrap(rerr, "XML API: "+err.Error())
+	}
+
+	return reading, nil
+}
+
+// PowerCapSet sets the power limit of the machine, a limit of zero removes the power limit.
+//
+// The limit is set through the ipmi.cgi XML API, on the X12 and X13 models which lack the XML API,
+// or when the XML API request fails, it is set on the Redfish Chassis Power resource instead.
+func (c *Client) PowerCapSet(ctx context.Context, watts int) error {
+	if c.serviceClient == nil || c.serviceClient.redfish == nil || c.bmc == nil {
+		return errors.Wrap(bmclibErrs.ErrLoginFailed, "client not initialized")
+	}
+
+	err := c.bmc.powerCapSet(ctx, watts)
+	if err == nil {
+		return nil
+	}
+
+	c.logRedfishFallback("PowerCapSet", err)
+
+	if rerr := c.serviceClient.redfish.PowerCapSet(ctx, watts); rerr != nil {
+		return errors.Wrap(rerr, "XML API: "+err.Error())
+	}
+
+	return nil
+}
+
+// logRedfishFallback logs the XML API error a method falls back to Redfish for.
+func (c *Client) logRedfishFallback(method string, err error) {
+	if errors.Is(err, ErrXMLAPIUnsupported) {
+		c.log.V(2).Info("XML API unsupported, falling back to Redfish", "method", method, "model", c.bmc.deviceModel())
+		return
+	}
+
+	c.log.Info("XML API request failed, falling back to Redfish", "method", method, "model", c.bmc.deviceModel(), "err", err.Error())
+}
+
 // BmcReset power cycles the BMC
 func (c *Client) BmcReset(ctx context.Context, resetType string) (ok bool, err error) {
 	if c.serviceClient == nil || c.serviceClient.redfish == nil {
@@ -334,6 +398,62 @@ func (c *Client) bmcQueryor(ctx context.Context) (bmcQueryor, error) {
 	return nil, errors.Wrapf(ErrModelUnknown, "failed to setup query client, had unsupported model: %s", model)
 }
 
+// ProbeCapabilities reports the BMC model and model family, and the features unsupported by the model.
+func (c *Client) ProbeCapabilities(_ context.Context) (bmc.Capabilities, error) {
+	if c.bmc == nil {
+		return bmc.Capabilities{}, errors.Wrap(bmclibErrs.ErrLoginFailed, "client not initialized")
+	}
+
+	model := c.bmc.deviceModel()
+	family := modelFamily(model)
+
+	caps := bmc.Capabilities{
+		Details: map[string]string{
+			"Model":       model,
+			"ModelFamily": family,
+		},
+	}
+
+	unsupported := registrar.Features{}
+	if err := c.serviceClient.supportsFirmwareInstall(model); err != nil {
+		unsupported = append(
+			unsupported,
+			providers.FeatureFirmwareUpload,
+			providers.FeatureFirmwareInstallUploaded,
+			providers.FeatureFirmwareTaskStatus,
+			providers.FeatureFirmwareInstallSteps,
+		)
+	}
+
+	// the X11 Redfish service does not include the BootProgress property.
+	if family == "x11" {
+		unsupported = append(unsupported, providers.FeatureBootProgress)
+	}
+
+	for _, f := range Features {
+		if slices.Contains(unsupported, f) {
+			caps.Unsupported = append(caps.Unsupported, f)
+			continue
+		}
+
+		caps.Features = append(caps.Features, f)
+	}
+
+	return caps, nil
+}
+
+// modelFamily returns the model family (x11, x12, x13) of the given board model.
+func modelFamily(model string) string {
+	model = strings.ToLower(model)
+	for _, family := range []string{"x11", "x12", "x13"} {
+		if strings.HasPrefix(model, family) {
+			return family
+		}
+	}
+
+	return ""
+}
+
 func parseToken(body []byte) string {
 	var key string
 	if bytes.Contains(body, []byte(`CSRF-TOKEN`)) {
@@ -1,7 +1,9 @@
 package supermicro
 
 type IPMI struct {
-	FruInfo *FruInfo `xml:"FRU_INFO,omitempty"`
+	FruInfo    *FruInfo    `xml:"FRU_INFO,omitempty"`
+	PSInfo     *PSInfo     `xml:"PSInfo,omitempty"`
+	PowerLimit *PowerLimit `xml:"POWER_LIMIT,omitempty"`
 }
 
 // FruInfo contains the FRU information
@@ -17,6 +19,24 @@ type Board struct {
 	SerialNum string `xml:"SERIAL_NUM,attr"`
 }
 
+// PSInfo contains the power supply readings
+type PSInfo struct {
+	PSItems []*PSItem `xml:"PSItem"`
+}
+
+// PSItem contains the readings of a power supply, the power values are in watts
+type PSItem struct {
+	Name      string `xml:"name,attr"`
+	Presence  string `xml:"presence,attr"`
+	ACInPower string `xml:"acInPower,attr"`
+}
+
+// PowerLimit contains the power limit configuration, the limit is in watts
+type PowerLimit struct {
+	Enable string `xml:"ENABLE,attr"`
+	Limit  string `xml:"LIMIT,attr"`
+}
+
 type Supermicro struct {
 	BIOS map[string]bool `json:"BIOS,omitempty"`
 	BMC  map[string]bool `json:"BMC,omitempty"`
@@ -0,0 +1,96 @@
+package supermicro
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/metal-toolbox/bmclib/bmc"
+	"github.com/pkg/errors"
+)
+
+// powerRead returns the power consumption summed over the present power supplies, along with the power limit.
+func (c *x11) powerRead(ctx context.Context) (bmc.PowerReading, error) {
+	data, err := c.powerQuery(ctx, "op=Get_PSInfoReadings.XML&r=(0,0)&_=")
+	if err != nil {
+		return bmc.PowerReading{}, err
+	}
+
+	if data.PSInfo == nil {
+		return bmc.PowerReading{}, errors.Wrap(ErrQueryPowerInfo, "power supply readings missing from response")
+	}
+
+	var reading bmc.PowerReading
+	for _, ps := range data.PSInfo.PSItems {
+		// absent power supply slots are listed as well
+		if ps == nil || ps.Presence != "1" {
+			continue
+		}
+
+		watts, err := strconv.ParseFloat(strings.TrimSpace(ps.ACInPower), 64)
+		if err != nil {
+			return bmc.PowerReading{}, errors.Wrap(ErrQueryPowerInfo, "power supply "+ps.Name+" input power: "+err.Error())
+		}
+
+		reading.Watts += watts
+	}
+
+	data, err = c.powerQuery(ctx, "op=POWER_LIMIT.XML&r=(0,0)&_=")
+	if err != nil {
+		return bmc.PowerReading{}, err
+	}
+
+	if data.PowerLimit == nil {
+		return bmc.PowerReading{}, errors.Wrap(ErrQueryPowerInfo, "power limit missing from response")
+	}
+
+	reading.CapEnabled = data.PowerLimit.Enable == "1"
+	if reading.CapEnabled {
+		reading.CapWatts, err = strconv.ParseFloat(strings.TrimSpace(data.PowerLimit.Limit), 64)
+		if err != nil {
+			return bmc.PowerReading{}, errors.Wrap(ErrQueryPowerInfo, "power limit: "+err.Error())
+		}
+	}
+
+	return reading, nil
+}
+
+// powerCapSet sets and enables the power limit, a limit of zero disables the power limit.
+func (c *x11) powerCapSet(ctx context.Context, watts int) error {
+	payload := fmt.Sprintf("op=POWER_LIMIT.XML&r=(1,1,%d)&_=", watts)
+	if watts == 0 {
+		payload = "op=POWER_LIMIT.XML&r=(1,0,0)&_="
+	}
+
+	_, err := c.powerQuery(ctx, payload)
+
+	return err
+}
+
+func (c *x11) powerQuery(ctx context.Context, payload string) (*IPMI, error) {
+	headers := map[string]string{"Content-Type": "application/x-www-form-urlencoded; charset=UTF-8"}
+
+	body, status, err := c.query(ctx, "cgi/ipmi.cgi", http.MethodPost, bytes.NewBufferString(payload), headers, 0)
+	if err != nil {
+		return nil, errors.Wrap(ErrQueryPowerInfo, err.Error())
+	}
+
+	if status != http.StatusOK {
+		return nil, unexpectedResponseErr([]byte(payload), body, status)
+	}
+
+	if !bytes.Contains(body, []byte(`<IPMI>`)) {
+		return nil, unexpectedResponseErr([]byte(payload), body, status)
+	}
+
+	data := &IPMI{}
+	if err := xml.Unmarshal(body, data); err != nil {
+		return nil, errors.Wrap(ErrQueryPowerInfo, err.Error())
+	}
+
+	return data, nil
+}
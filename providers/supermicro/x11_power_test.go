@@ -0,0 +1,162 @@
+package supermicro
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/metal-toolbox/bmclib/bmc"
+	"github.com/metal-toolbox/bmclib/internal/httpclient"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestX11(t *testing.T, handler func(http.ResponseWriter, *http.Request)) *x11 {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/cgi/ipmi.cgi", handler)
+
+	server := httptest.NewTLSServer(mux)
+	t.Cleanup(server.Close)
+
+	parsedURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	serviceClient, err := newBmcServiceClient(parsedURL.Hostname(), parsedURL.Port(), "foo", "bar", httpclient.Build())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &x11{serviceClient: serviceClient, log: logr.Discard()}
+}
+
+func TestX11PowerRead(t *testing.T) {
+	testcases := []struct {
+		name          string
+		limit         string
+		want          bmc.PowerReading
+		errorContains string
+	}{
+		{
+			"power limit enabled",
+			`<IPMI><POWER_LIMIT ENABLE="1" LIMIT="400"/></IPMI>`,
+			bmc.PowerReading{Watts: 312, CapWatts: 400, CapEnabled: true},
+			"",
+		},
+		{
+			"power limit disabled",
+			`<IPMI><POWER_LIMIT ENABLE="0" LIMIT="0"/></IPMI>`,
+			bmc.PowerReading{Watts: 312},
+			"",
+		},
+		{
+			"power limit missing",
+			`<IPMI></IPMI>`,
+			bmc.PowerReading{},
+			"power limit missing from response",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newTestX11(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "application/x-www-form-urlencoded; charset=UTF-8", r.Header.Get("Content-Type"))
+
+				b, err := io.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				switch string(b) {
+				case `op=Get_PSInfoReadings.XML&r=(0,0)&_=`:
+					_, _ = w.Write([]byte(`<?xml version="1.0"?>
+				<IPMI>
+				  <PSInfo>
+				    <PSItem name="PWS-1" presence="1" acInPower="150"/>
+				    <PSItem name="PWS-2" presence="1" acInPower="162"/>
+				    <PSItem name="PWS-3" presence="0" acInPower="0"/>
+				  </PSInfo>
+				</IPMI>`))
+				case `op=POWER_LIMIT.XML&r=(0,0)&_=`:
+					_, _ = w.Write([]byte(tc.limit))
+				default:
+					t.Errorf("unexpected payload: %s", b)
+				}
+			})
+
+			got, err := client.powerRead(context.Background())
+			if tc.errorContains != "" {
+				assert.ErrorContains(t, err, tc.errorContains)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestX11PowerCapSet(t *testing.T) {
+	testcases := []struct {
+		name          string
+		watts         int
+		payload       string
+		status        int
+		errorContains string
+	}{
+		{
+			"set power limit",
+			400,
+			`op=POWER_LIMIT.XML&r=(1,1,400)&_=`,
+			http.StatusOK,
+			"",
+		},
+		{
+			"remove power limit",
+			0,
+			`op=POWER_LIMIT.XML&r=(1,0,0)&_=`,
+			http.StatusOK,
+			"",
+		},
+		{
+			"error returned",
+			400,
+			`op=POWER_LIMIT.XML&r=(1,1,400)&_=`,
+			http.StatusBadRequest,
+			"400",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newTestX11(t, func(w http.ResponseWriter, r *http.Request) {
+				b, err := io.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				assert.Equal(t, tc.payload, string(b))
+
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(`<IPMI><POWER_LIMIT ENABLE="1" LIMIT="400"/></IPMI>`))
+			})
+
+			err := client.powerCapSet(context.Background(), tc.watts)
+			if tc.errorContains != "" {
+				assert.ErrorContains(t, err, tc.errorContains)
+
+				return
+			}
+
+			assert.Nil(t, err)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/go-logr/logr"
 	common "github.com/metal-toolbox/bmc-common"
+	"github.com/metal-toolbox/bmclib/bmc"
 	"github.com/metal-toolbox/bmclib/constants"
 	brrs "github.com/metal-toolbox/bmclib/errors"
 	rfw "github.com/metal-toolbox/bmclib/internal/redfishwrapper"
@@ -270,3 +271,11 @@ func (c *x12) bootComplete() (bool, error) {
 	// we determined this by experiment on X12STH-SYS with redfish 1.14.0
 	return bp.LastState == redfish.SystemHardwareInitializationCompleteBootProgressTypes, nil
 }
+
+func (c *x12) powerRead(ctx context.Context) (bmc.PowerReading, error) {
+	return bmc.PowerReading{}, errors.Wrap(ErrXMLAPIUnsupported, "power reading not supported on x12 models")
+}
+
+func (c *x12) powerCapSet(ctx context.Context, watts int) error {
+	return errors.Wrap(ErrXMLAPIUnsupported, "power limit not supported on x12 models")
+}
@@ -0,0 +1,110 @@
+package bmclib
+
+import (
+	"context"
+	"time"
+
+	"github.com/metal-toolbox/bmclib/bmc"
+)
+
+// default interval between System Event Log polls
+const defaultSystemEventLogPollInterval = time.Minute
+
+// GetSystemEventLogSince returns the System Event Log entries logged after the cursor, along with the cursor
+// to pass to the next call. A zero cursor returns all of the entries, see bmc.SystemEventLogEntriesSince.
+//
+// The providers return the whole log, the entries are filtered after they are read.
+func (c *Client) GetSystemEventLogSince(ctx context.Context, cursor bmc.SystemEventLogCursor) (entries []bmc.SystemEventLogEntry, next bmc.SystemEventLogCursor, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "GetSystemEventLogSince")
+	defer span.End()
+
+	ctx = c.withCallOptions(ctx)
+
+	all, metadata, err := bmc.GetSystemEventLogEntriesFromInterfaces(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
+	if c.reauthenticate(ctx, metadata, err) {
+		all, metadata, err = bmc.GetSystemEventLogEntriesFromInterfaces(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
+	}
+	c.setMetadata(ctx, metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	if err != nil {
+		return nil, cursor, err
+	}
+
+	entries, next = bmc.SystemEventLogEntriesSince(all, cursor)
+
+	return entries, next, nil
+}
+
+// SystemEventLogWatchOptions configure WatchSystemEventLog.
+type SystemEventLogWatchOptions struct {
+	// Cursor is the position to watch from, entries logged after it are sent, all of the entries in the log
+	// are sent on the first poll when it is zero.
+	Cursor bmc.SystemEventLogCursor
+	// PollInterval is the time between System Event Log polls, defaults to 1 minute.
+	PollInterval time.Duration
+}
+
+// SystemEventLogEvent is a new System Event Log entry or a failed poll, sent by WatchSystemEventLog.
+type SystemEventLogEvent struct {
+	Entry bmc.SystemEventLogEntry
+	// Cursor is the position after the Entry, it can be stored to resume watching from this entry.
+	Cursor bmc.SystemEventLogCursor
+	// Err is set when the System Event Log could not be read, the Entry is then empty and
+	// the log is polled again on the next interval.
+	Err error
+}
+
+// WatchSystemEventLog polls the System Event Log and streams the entries logged after the options Cursor,
+// the first poll is made right away. The channel is closed once the context is canceled.
+func (c *Client) WatchSystemEventLog(ctx context.Context, opts SystemEventLogWatchOptions) <-chan SystemEventLogEvent {
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = defaultSystemEventLogPollInterval
+	}
+
+	events := make(chan SystemEventLogEvent)
+
+	go func() {
+		defer close(events)
+
+		send := func(event SystemEventLogEvent) bool {
+			select {
+			case <-ctx.Done():
+				return false
+			case events <- event:
+				return true
+			}
+		}
+
+		cursor := opts.Cursor
+		for {
+			entries, next, err := c.GetSystemEventLogSince(ctx, cursor)
+			if err != nil && ctx.Err() != nil {
+				return
+			}
+
+			if err != nil {
+				if !send(SystemEventLogEvent{Cursor: cursor, Err: err}) {
+					return
+				}
+			}
+
+			for _, entry := range entries {
+				cursor = cursor.Advance(entry)
+				if !send(SystemEventLogEvent{Entry: entry, Cursor: cursor}) {
+					return
+				}
+			}
+
+			cursor = next
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(opts.PollInterval):
+			}
+		}
+	}()
+
+	return events
+}
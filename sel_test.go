@@ -0,0 +1,96 @@
+package bmclib
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jacobweinstock/registrar"
+	"github.com/metal-toolbox/bmclib/bmc"
+	"github.com/stretchr/testify/assert"
+)
+
+// selSequenceProvider returns the next System Event Log of its sequence on each read,
+// the last one is returned once the sequence is exhausted. A nil log fails the read.
+type selSequenceProvider struct {
+	mu    sync.Mutex
+	logs  [][]bmc.SystemEventLogEntry
+	reads int
+}
+
+func (p *selSequenceProvider) Name() string {
+	return "tester"
+}
+
+func (p *selSequenceProvider) GetSystemEventLogEntries(ctx context.Context) ([]bmc.SystemEventLogEntry, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	log := p.logs[min(p.reads, len(p.logs)-1)]
+	p.reads++
+
+	if log == nil {
+		return nil, errors.New("sel read failed")
+	}
+
+	return log, nil
+}
+
+func TestGetSystemEventLogSince(t *testing.T) {
+	entries := []bmc.SystemEventLogEntry{{ID: "1"}, {ID: "2"}, {ID: "3"}}
+
+	registry := registrar.NewRegistry()
+	registry.Register("tester", "tester", nil, nil, &selSequenceProvider{logs: [][]bmc.SystemEventLogEntry{entries}})
+	cl := NewClient("", "", "", WithRegistry(registry))
+
+	got, cursor, err := cl.GetSystemEventLogSince(context.Background(), bmc.SystemEventLogCursor{ID: "1"})
+	assert.Nil(t, err)
+	assert.Equal(t, entries[1:], got)
+	assert.Equal(t, bmc.SystemEventLogCursor{ID: "3"}, cursor)
+
+	got, cursor, err = cl.GetSystemEventLogSince(context.Background(), cursor)
+	assert.Nil(t, err)
+	assert.Empty(t, got)
+	assert.Equal(t, bmc.SystemEventLogCursor{ID: "3"}, cursor)
+}
+
+func TestWatchSystemEventLog(t *testing.T) {
+	e1, e2, e3 := bmc.SystemEventLogEntry{ID: "1"}, bmc.SystemEventLogEntry{ID: "2"}, bmc.SystemEventLogEntry{ID: "3"}
+
+	provider := &selSequenceProvider{logs: [][]bmc.SystemEventLogEntry{
+		{e1},
+		{e1, e2},
+		nil,
+		{e1, e2, e3},
+	}}
+
+	registry := registrar.NewRegistry()
+	registry.Register("tester", "tester", nil, nil, provider)
+	cl := NewClient("", "", "", WithRegistry(registry))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	events := cl.WatchSystemEventLog(ctx, SystemEventLogWatchOptions{PollInterval: time.Millisecond})
+
+	var ids []string
+	var errs int
+	for event := range events {
+		if event.Err != nil {
+			errs++
+			assert.Equal(t, bmc.SystemEventLogCursor{ID: "2"}, event.Cursor)
+			continue
+		}
+
+		ids = append(ids, event.Entry.ID)
+		assert.Equal(t, event.Entry.ID, event.Cursor.ID)
+		if event.Entry.ID == "3" {
+			cancel()
+		}
+	}
+
+	assert.Equal(t, []string{"1", "2", "3"}, ids)
+	assert.Equal(t, 1, errs)
+}